@@ -3,6 +3,7 @@ package furya
 import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	furyaappparams "github.com/furysport/fury-chain/app/params"
 	airdropkeeper "github.com/furysport/fury-chain/x/airdrop/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,7 +19,7 @@ import (
 )
 
 var minCommissionRate = sdk.NewDecWithPrec(5, 2) // 5%
-var maxVotingPower = sdk.NewDecWithPrec(66, 1)   // 6.6%
+var maxVotingPower = furyaappparams.MaxVotingPower
 
 // HandlerOptions extends the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
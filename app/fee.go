@@ -2,6 +2,7 @@ package furya
 
 import (
 	"fmt"
+	"sync"
 
 	airdropkeeper "github.com/furysport/fury-chain/x/airdrop/keeper"
 	airdroptypes "github.com/furysport/fury-chain/x/airdrop/types"
@@ -11,6 +12,51 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+const (
+	// feelessClaimWindow is the number of blocks over which failed fee-less claims are rate limited
+	feelessClaimWindow = 100
+	// maxFeelessClaimFailures is the max number of failed fee-less claims of an address in a window
+	maxFeelessClaimFailures = 3
+)
+
+// feelessClaimLimiter rate limits failed fee-less claims per claimed address on CheckTx.
+// Failed claims are reverted with their tx so they cannot be counted in the state,
+// the limiter is local to the node and protects its mempool from repeated invalid claims.
+type feelessClaimLimiter struct {
+	mu          sync.Mutex
+	windowStart int64
+	failures    map[string]uint32
+}
+
+func newFeelessClaimLimiter() *feelessClaimLimiter {
+	return &feelessClaimLimiter{failures: map[string]uint32{}}
+}
+
+func (l *feelessClaimLimiter) resetWindow(height int64) {
+	if height >= l.windowStart+feelessClaimWindow {
+		l.windowStart = height
+		l.failures = map[string]uint32{}
+	}
+}
+
+// Allow returns false when the address failed too many fee-less claims in the window.
+func (l *feelessClaimLimiter) Allow(height int64, address string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.resetWindow(height)
+	return l.failures[address] < maxFeelessClaimFailures
+}
+
+// RecordFailure counts a failed fee-less claim of the address.
+func (l *feelessClaimLimiter) RecordFailure(height int64, address string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.resetWindow(height)
+	l.failures[address]++
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -20,6 +66,7 @@ type DeductFeeDecorator struct {
 	bankKeeper     types.BankKeeper
 	feegrantKeeper ante.FeegrantKeeper
 	airdropKeeper  *airdropkeeper.Keeper
+	claimLimiter   *feelessClaimLimiter
 }
 
 func NewDeductFeeDecorator(ak ante.AccountKeeper, bk types.BankKeeper, fk ante.FeegrantKeeper, airdropKeeper *airdropkeeper.Keeper) DeductFeeDecorator {
@@ -28,6 +75,7 @@ func NewDeductFeeDecorator(ak ante.AccountKeeper, bk types.BankKeeper, fk ante.F
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		airdropKeeper:  airdropKeeper,
+		claimLimiter:   newFeelessClaimLimiter(),
 	}
 }
 
@@ -72,14 +120,28 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		case *airdroptypes.MsgClaimAllocation:
 			msg := msg.(*airdroptypes.MsgClaimAllocation)
 			signer := msg.GetSigners()[0]
-			cacheCtx, _ := ctx.CacheContext()
 			if acc := dfd.ak.GetAccount(ctx, signer); acc == nil {
+				// repeated invalid claims are only rate limited on new txs of the mempool,
+				// the per block cap is part of the state
+				limited := ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate
+				if limited && !dfd.claimLimiter.Allow(ctx.BlockHeight(), msg.Address) {
+					return ctx, sdkerrors.Wrapf(airdroptypes.ErrFeelessClaimRateLimited, "address: %s", msg.Address)
+				}
+				if dfd.airdropKeeper.FeelessClaimCapReached(ctx) {
+					return ctx, airdroptypes.ErrFeelessClaimCapReached
+				}
+
+				cacheCtx, _ := ctx.CacheContext()
 				dfd.ak.SetAccount(cacheCtx, types.NewBaseAccountWithAddress(signer))
-				err := dfd.airdropKeeper.ClaimAllocation(cacheCtx, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+				err := dfd.airdropKeeper.TrialClaimAllocation(cacheCtx, msg)
 				if err == nil {
+					dfd.airdropKeeper.IncrementFeelessClaimCount(ctx)
 					dfd.ak.SetAccount(ctx, types.NewBaseAccountWithAddress(signer))
 					return next(ctx, tx, simulate)
 				}
+				if limited {
+					dfd.claimLimiter.RecordFailure(ctx.BlockHeight(), msg.Address)
+				}
 			}
 		}
 	}
@@ -0,0 +1,103 @@
+package furya_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	furya "github.com/furysport/fury-chain/app"
+	airdropkeeper "github.com/furysport/fury-chain/x/airdrop/keeper"
+	airdroptypes "github.com/furysport/fury-chain/x/airdrop/types"
+	minttypes "github.com/furysport/fury-chain/x/mint/types"
+)
+
+func TestDeductFeeDecoratorFeelessClaims(t *testing.T) {
+	app := furya.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "furya-test-1", Height: 10})
+	encodingConfig := furya.MakeEncodingConfig()
+
+	coins := sdk.Coins{sdk.NewInt64Coin("ufury", 40000)}
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, airdroptypes.ModuleName, coins))
+
+	newClaim := func(validSignature bool) *airdroptypes.MsgClaimAllocation {
+		privKey := secp256k1.GenPrivKey()
+		address, err := bech32.ConvertAndEncode("cosmos", privKey.PubKey().Address())
+		require.NoError(t, err)
+		rewardAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+		app.AirdropKeeper.SetAllocation(ctx, airdroptypes.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       address,
+			Amount:        sdk.NewInt64Coin("ufury", 4000),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+		})
+
+		signBytes, err := airdropkeeper.GetSignBytes(ctx.ChainID(), 0, "cosmos", address, rewardAddr)
+		require.NoError(t, err)
+		if !validSignature {
+			signBytes = append(signBytes, ' ')
+		}
+		signature, err := privKey.Sign(airdropkeeper.ADR036SignBytes(address, signBytes))
+		require.NoError(t, err)
+		return &airdroptypes.MsgClaimAllocation{
+			Address:       address,
+			PubKey:        hexutil.Encode(privKey.PubKey().Bytes()),
+			RewardAddress: rewardAddr,
+			Signature:     hexutil.Encode(signature),
+		}
+	}
+
+	decorator := furya.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, &app.AirdropKeeper)
+	anteHandle := func(ctx sdk.Context, msg sdk.Msg) error {
+		txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(200000)
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+
+	// repeated invalid claims of an address are rate limited on CheckTx
+	checkCtx, _ := ctx.WithIsCheckTx(true).CacheContext()
+	invalid := newClaim(false)
+	for i := 0; i < 3; i++ {
+		err := anteHandle(checkCtx, invalid)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, airdroptypes.ErrFeelessClaimRateLimited)
+	}
+	require.ErrorIs(t, anteHandle(checkCtx, invalid), airdroptypes.ErrFeelessClaimRateLimited)
+	require.NotErrorIs(t, anteHandle(ctx, invalid), airdroptypes.ErrFeelessClaimRateLimited)
+	require.NotErrorIs(t, anteHandle(checkCtx.WithBlockHeight(110), invalid), airdroptypes.ErrFeelessClaimRateLimited)
+
+	// other addresses are not limited
+	require.NoError(t, anteHandle(checkCtx, newClaim(true)))
+
+	// fee-less claims are capped per block
+	params := app.AirdropKeeper.GetParamSet(ctx)
+	params.MaxFeelessClaimsPerBlock = 1
+	app.AirdropKeeper.SetParamSet(ctx, params)
+	require.NoError(t, anteHandle(ctx, newClaim(true)))
+	require.Equal(t, uint64(1), app.AirdropKeeper.GetFeelessClaimCount(ctx))
+	capped := newClaim(true)
+	require.ErrorIs(t, anteHandle(ctx, capped), airdroptypes.ErrFeelessClaimCapReached)
+	nextBlockCtx := ctx.WithBlockHeight(11)
+	require.Equal(t, uint64(0), app.AirdropKeeper.GetFeelessClaimCount(nextBlockCtx))
+
+	// the trial claim is bounded by the fee-less claim gas limit
+	params.FeelessClaimGasLimit = 1000
+	app.AirdropKeeper.SetParamSet(ctx, params)
+	err := anteHandle(nextBlockCtx, capped)
+	require.Error(t, err)
+	require.Nil(t, app.AccountKeeper.GetAccount(nextBlockCtx, capped.GetSigners()[0]))
+
+	params.FeelessClaimGasLimit = 400000
+	app.AirdropKeeper.SetParamSet(ctx, params)
+	require.NoError(t, anteHandle(nextBlockCtx, capped))
+	require.NotNil(t, app.AccountKeeper.GetAccount(nextBlockCtx, capped.GetSigners()[0]))
+}
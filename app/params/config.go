@@ -27,6 +27,9 @@ var (
 	Bech32PrefixConsPub = Bech32PrefixAccAddr + "valconspub"
 )
 
+// MaxVotingPower is the voting power percentage a validator cannot reach through new delegations.
+var MaxVotingPower = sdk.NewDecWithPrec(66, 1) // 6.6%
+
 func init() {
 	SetAddressPrefixes()
 	RegisterDenoms()
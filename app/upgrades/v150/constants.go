@@ -0,0 +1,20 @@
+package v150
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/furysport/fury-chain/app/upgrades"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v1.5.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{},
+	},
+}
@@ -0,0 +1,21 @@
+package v150
+
+import (
+	"github.com/furysport/fury-chain/app/keepers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// CreateUpgradeHandler runs the store migrations of the airdrop and intertx modules from version 1 to 2
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("start to run module migrations...")
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
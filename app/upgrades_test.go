@@ -0,0 +1,47 @@
+package furya_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	furya "github.com/furysport/fury-chain/app"
+	v150 "github.com/furysport/fury-chain/app/upgrades/v150"
+	airdroptypes "github.com/furysport/fury-chain/x/airdrop/types"
+	intertxtypes "github.com/furysport/fury-chain/x/intertx/types"
+)
+
+func TestUpgradeV150(t *testing.T) {
+	app := furya.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{ChainID: "furya-test-1", Height: 10})
+
+	// the airdrop and intertx stores of version 1
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	vm[airdroptypes.ModuleName] = 1
+	vm[intertxtypes.ModuleName] = 1
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+	address := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	allocation := airdroptypes.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       address,
+		Amount:        sdk.NewInt64Coin("ufury", 500),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+	}
+	store := ctx.KVStore(app.GetKey(airdroptypes.StoreKey))
+	store.Set(append(airdroptypes.KeyPrefixAirdropAllocation, []byte(address)...), app.AppCodec().MustMarshal(&allocation))
+	app.InterTxKeeper.SetParams(ctx, intertxtypes.NewParams(time.Minute, time.Minute, time.Minute))
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v150.UpgradeName, Height: ctx.BlockHeight()})
+
+	vm = app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(2), vm[airdroptypes.ModuleName])
+	require.Equal(t, uint64(2), vm[intertxtypes.ModuleName])
+	require.Equal(t, &allocation, app.AirdropKeeper.GetAllocation(ctx, 0, address))
+	require.Equal(t, sdk.NewInt64Coin("ufury", 400), app.AirdropKeeper.GetReservedAmount(ctx, 0, "ufury"))
+	require.Equal(t, intertxtypes.DefaultParams(), app.InterTxKeeper.GetParams(ctx))
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	appparams "github.com/furysport/fury-chain/app/params"
+	airdroptypes "github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// MerkleAirdropFile is the output of build-merkle-airdrop, holding the root of the
+// allocation tree and the claim of every address in the airdrop file.
+type MerkleAirdropFile struct {
+	MerkleRoot  string                              `json:"merkle_root"`
+	TotalAmount string                              `json:"total_amount"`
+	Claims      map[string]airdroptypes.MerkleClaim `json:"claims"`
+}
+
+func readMerkleAirdropFile(path string) (MerkleAirdropFile, error) {
+	file := MerkleAirdropFile{}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+	err = json.Unmarshal(bz, &file)
+	return file, err
+}
+
+func parseAirdropCsv(path string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return csv.NewReader(f).ReadAll()
+}
+
+// BuildMerkleAirdropCmd returns build merkle airdrop cobra Command.
+func BuildMerkleAirdropCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build-merkle-airdrop [chain] [airdrop_file_path] [output_path]",
+		Short: "Build merkle root and proofs from an airdrop csv file",
+		Long: `Build merkle root and proofs from an airdrop csv file with address and amount columns.
+Example:
+	furyad tx airdrop build-merkle-airdrop cosmos further_airdrop.csv further_airdrop_merkle.json
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chain := args[0]
+			allocationRecords, err := parseAirdropCsv(args[1])
+			if err != nil {
+				return err
+			}
+			if len(allocationRecords) < 2 {
+				return fmt.Errorf("no allocation in %s", args[1])
+			}
+
+			claims := []airdroptypes.MerkleClaim{}
+			addresses := []string{}
+			leaves := [][]byte{}
+			total := sdk.NewInt64Coin(appparams.BaseCoinUnit, 0)
+			for index, line := range allocationRecords[1:] {
+				addr, amountStr := airdroptypes.NormalizeAddress(line[0]), line[1]
+				amountDec, err := sdk.NewDecFromStr(amountStr)
+				if err != nil {
+					return err
+				}
+				amount := sdk.NewCoin(appparams.BaseCoinUnit, amountDec.Mul(sdk.NewDec(1000_000)).TruncateInt())
+
+				claims = append(claims, airdroptypes.MerkleClaim{
+					Index:  uint64(index),
+					Chain:  chain,
+					Amount: amount,
+				})
+				addresses = append(addresses, addr)
+				leaves = append(leaves, airdroptypes.MerkleLeafHash(uint64(index), chain, addr, amount))
+				total = total.Add(amount)
+			}
+
+			root, proofs := airdroptypes.BuildMerkleTree(leaves)
+			file := MerkleAirdropFile{
+				MerkleRoot:  hex.EncodeToString(root),
+				TotalAmount: total.String(),
+				Claims:      map[string]airdroptypes.MerkleClaim{},
+			}
+			for i, claim := range claims {
+				for _, sibling := range proofs[i] {
+					claim.Proof = append(claim.Proof, hex.EncodeToString(sibling))
+				}
+				file.Claims[addresses[i]] = claim
+			}
+
+			bz, err := json.MarshalIndent(file, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[2], bz, 0o644); err != nil {
+				return err
+			}
+
+			fmt.Println("merkle root", file.MerkleRoot, "total amount", file.TotalAmount)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetTxCreateMerkleAirdropCmd implement cli command for MsgCreateMerkleAirdrop
+func GetTxCreateMerkleAirdropCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-merkle-airdrop [merkle_root] [total_amount]",
+		Short: "Create merkle airdrop",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			totalAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
+			msg := airdroptypes.NewMsgCreateMerkleAirdrop(
+				clientCtx.GetFromAddress(),
+				args[0],
+				totalAmount,
+				campaignId,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign funding the merkle airdrop, zero for the module owner pool")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// OwnerMsgsProposalJSON defines the proposal file of an airdrop owner msgs proposal
+type OwnerMsgsProposalJSON struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+	Deposit     string            `json:"deposit"`
+}
+
+// GetCmdSubmitOwnerMsgsProposal implements the command to submit an airdrop owner msgs proposal
+func GetCmdSubmitOwnerMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop-owner-msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing airdrop messages as the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing airdrop messages signed by the governance module account %s,
+for the module owner pool or campaigns owned by governance, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal airdrop-owner-msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Airdrop owner pool withdrawal",
+  "description": "Withdraw the unallocated owner pool balance",
+  "messages": [
+    {
+      "@type": "/furya.airdrop.v1beta1.MsgWithdrawTokens",
+      "sender": "%s",
+      "campaign_id": "0",
+      "amount": [{"denom": "ufury", "amount": "1000"}],
+      "recipient": ""
+    }
+  ],
+  "deposit": "1000ufury"
+}
+`,
+				types.GovModuleAddress(), version.AppName, types.GovModuleAddress(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			proposal := OwnerMsgsProposalJSON{}
+			if err := json.Unmarshal(bz, &proposal); err != nil {
+				return err
+			}
+
+			msgs := make([]sdk.Msg, len(proposal.Messages))
+			for i, raw := range proposal.Messages {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+					return fmt.Errorf("invalid message %d: %w", i, err)
+				}
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content, err := types.NewOwnerMsgsProposal(proposal.Title, proposal.Description, msgs)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
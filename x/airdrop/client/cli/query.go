@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/furysport/fury-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,7 +24,18 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryAllocation(),
+		GetCmdQueryClaimRecord(),
+		GetCmdQueryClaimReceipts(),
+		GetCmdQuerySimulateClaim(),
+		GetCmdQueryMerkleAirdrop(),
+		GetCmdQueryMerkleLeafClaimed(),
+		GetCmdQueryCampaign(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryAllocationUpload(),
+		GetCmdQuerySnapshot(),
+		GetCmdQuerySolvency(),
 		GetCmdQueryParams(),
+		GetCmdQueryOwnership(),
 		GetCmdQueryAirdropModuleAccount(),
 	)
 
@@ -33,12 +45,17 @@ func GetQueryCmd() *cobra.Command {
 func GetCmdQueryAllocation() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "allocation [addr]",
-		Short: "Query allocations of an address",
+		Short: "Query the allocation of an address in a campaign",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
-			params := &types.QueryAllocationRequest{Address: args[0]}
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllocationRequest{Address: args[0], CampaignId: campaignId}
 
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Allocation(context.Background(), params)
@@ -50,7 +67,317 @@ func GetCmdQueryAllocation() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the allocation, zero for the module owner pool")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryClaimRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-record [addr]",
+		Short: "Query the claim record of an address in a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryClaimRecordRequest{Address: args[0], CampaignId: campaignId}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimRecord(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.ClaimRecord)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the claimed allocation, zero for the module owner pool")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryClaimReceipts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-receipts [reward_address]",
+		Short: "Query the payouts of the allocations claimed by a furya address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryClaimReceiptsRequest{RewardAddress: args[0], Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimReceipts(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "claim-receipts")
+
+	return cmd
+}
+
+func GetCmdQuerySimulateClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-claim [native_chain_address] [reward_address] [signature]",
+		Short: "Simulate a claim without state changes",
+		Long: `Simulate a claim of the allocation of the native chain address to the reward address and print whether
+it would be accepted, whether the signature verifies and the amount it would pay out, or why it would be rejected.
+The flags are the ones of claim-allocation.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			claim := types.MsgClaimAllocation{
+				Address:       args[0],
+				RewardAddress: args[1],
+				Signature:     args[2],
+			}
+			err := parseClaimFlags(cmd, &claim)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySimulateClaimRequest{Claim: claim}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateClaim(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addClaimFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryMerkleAirdrop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-airdrop [id]",
+		Short: "Query merkle airdrop by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMerkleAirdropRequest{Id: id}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MerkleAirdrop(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.MerkleAirdrop)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryMerkleLeafClaimed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merkle-leaf-claimed [id] [index]",
+		Short: "Query if a leaf of a merkle airdrop is claimed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMerkleLeafClaimedRequest{Id: id, Index: index}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MerkleLeafClaimed(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaign [id]",
+		Short: "Query campaign by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCampaignRequest{Id: id}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Campaign(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Campaign)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryAllocationUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocation-upload [campaign_id]",
+		Short: "Query the progress of the bulk allocation upload of a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllocationUploadRequest{CampaignId: campaignId}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllocationUpload(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot [campaign_id]",
+		Short: "Query the on-chain snapshot a campaign was created from",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			campaignId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySnapshotRequest{CampaignId: campaignId}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Snapshot(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySolvency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "solvency",
+		Short: "Query the module account balance and the amount left to claim of every denom",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Solvency(context.Background(), &types.QuerySolvencyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "campaigns",
+		Short: "Query all campaigns",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCampaignsRequest{Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Campaigns(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaigns")
 
 	return cmd
 }
@@ -72,6 +399,29 @@ func GetCmdQueryAirdropModuleAccount() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership",
+		Short: "Query the module owner and the pending owner",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Ownership(context.Background(), &types.QueryOwnershipRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
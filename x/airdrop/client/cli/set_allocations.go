@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// parseAllocationsFile reads chain,address,amount records of a csv file, with an optional header line.
+func parseAllocationsFile(path string, campaignId uint64) ([]types.AirdropAllocation, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && strings.EqualFold(records[0][0], "chain") {
+		records = records[1:]
+	}
+
+	allocations := []types.AirdropAllocation{}
+	for i, record := range records {
+		if len(record) != 3 {
+			return nil, fmt.Errorf("invalid record %d: expected chain,address,amount", i)
+		}
+		amount, err := sdk.ParseCoinNormalized(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of record %d: %w", i, err)
+		}
+		allocations = append(allocations, types.AirdropAllocation{
+			Chain:         record[0],
+			Address:       record[1],
+			Amount:        amount,
+			ClaimedAmount: sdk.NewCoin(amount.Denom, sdk.ZeroInt()),
+			CampaignId:    campaignId,
+		})
+	}
+	return allocations, nil
+}
+
+// resumeAllocationsUpload returns the number of allocations already uploaded on-chain and the upload checksum,
+// zero and an empty checksum when the on-chain upload is not a prefix of the allocations.
+func resumeAllocationsUpload(upload *types.AllocationUpload, allocations []types.AirdropAllocation, total sdk.Coin) (int, string, error) {
+	if upload == nil || upload.Count > uint64(len(allocations)) || !upload.Total.IsEqual(total) {
+		return 0, "", nil
+	}
+	checksum, err := types.AllocationsChecksum("", allocations[:upload.Count])
+	if err != nil {
+		return 0, "", err
+	}
+	if checksum != upload.Checksum {
+		return 0, "", nil
+	}
+	return int(upload.Count), checksum, nil
+}
+
+// GetTxSetAllocationsCmd implement cli command for MsgSetAllocations
+func GetTxSetAllocationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-allocations [allocations_file_path] [flags]",
+		Short: "Upload the allocations of a csv file in batches",
+		Long: `Upload the chain,address,amount allocations of a csv file in batches of MsgSetAllocations.
+The upload resumes after the allocations already on-chain when a previous upload of the same file was interrupted,
+batches must be committed before the next one is sent.
+Example:
+	furyad tx airdrop set-allocations allocations.csv --campaign-id=1 --batch-size=500 --from=partner --broadcast-mode=block --gas=10000000
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid batch size: %d", batchSize)
+			}
+
+			allocations, err := parseAllocationsFile(args[0], campaignId)
+			if err != nil {
+				return err
+			}
+			if len(allocations) == 0 {
+				return fmt.Errorf("no allocations in %s", args[0])
+			}
+			total := sdk.NewCoin(allocations[0].Amount.Denom, sdk.ZeroInt())
+			for _, allocation := range allocations {
+				if allocation.Amount.Denom != total.Denom {
+					return fmt.Errorf("allocations of %s are not in %s", allocation.Address, total.Denom)
+				}
+				total = total.Add(allocation.Amount)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllocationUpload(context.Background(), &types.QueryAllocationUploadRequest{CampaignId: campaignId})
+			if err != nil {
+				return err
+			}
+			start, checksum, err := resumeAllocationsUpload(res.AllocationUpload, allocations, total)
+			if err != nil {
+				return err
+			}
+			if start == len(allocations) {
+				fmt.Println("allocations already uploaded, checksum", checksum)
+				return nil
+			}
+			if start > 0 {
+				fmt.Println("resuming upload after", start, "allocations already on-chain")
+			}
+
+			for start < len(allocations) {
+				end := start + batchSize
+				if end > len(allocations) {
+					end = len(allocations)
+				}
+				batch := allocations[start:end]
+				batchChecksum, err := types.AllocationsChecksum(checksum, batch)
+				if err != nil {
+					return err
+				}
+
+				msg := types.NewMsgSetAllocations(clientCtx.GetFromAddress().String(), campaignId, batch, checksum, batchChecksum, total)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg); err != nil {
+					return err
+				}
+				fmt.Println("executed until index", end-1)
+
+				start, checksum = end, batchChecksum
+			}
+
+			fmt.Println("finalized upload of allocations, checksum", checksum)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the allocations, zero for the module owner pool")
+	cmd.Flags().Int(FlagBatchSize, 500, "Number of allocations per transaction")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,432 @@
+package cli
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	solana "github.com/gagliardetto/solana-go"
+	"github.com/spf13/cobra"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+const (
+	FlagAddress            = "address"
+	FlagPrivateKey         = "private-key"
+	FlagMnemonic           = "mnemonic"
+	FlagHDPath             = "hd-path"
+	FlagLegacy             = "legacy"
+	FlagBitcoinAddressType = "bitcoin-address-type"
+
+	BitcoinP2PKH      = "p2pkh"
+	BitcoinP2SHP2WPKH = "p2sh-p2wpkh"
+	BitcoinP2WPKH     = "p2wpkh"
+)
+
+// adr036Prefixes are the bech32 prefixes of the chains verifying ADR-036 signatures of secp256k1 keys
+var adr036Prefixes = map[string]string{
+	"secret":            "secret",
+	"stargaze":          "stars",
+	"osmosis":           "osmo",
+	"juno":              "juno",
+	"cosmos":            "cosmos",
+	types.SnapshotChain: "furya",
+}
+
+// ethAdr036Prefixes are the bech32 prefixes of the chains verifying ADR-036 signatures of ethsecp256k1 keys
+var ethAdr036Prefixes = map[string]string{
+	"evmos":     "evmos",
+	"injective": "inj",
+}
+
+// defaultHDPaths are the derivation paths of the wallets of each chain
+var defaultHDPaths = map[string]string{
+	"evm":               "m/44'/60'/0'/0/0",
+	"evmos":             "m/44'/60'/0'/0/0",
+	"injective":         "m/44'/60'/0'/0/0",
+	"terra":             "m/44'/330'/0'/0/0",
+	"secret":            "m/44'/529'/0'/0/0",
+	"stargaze":          "m/44'/118'/0'/0/0",
+	"osmosis":           "m/44'/118'/0'/0/0",
+	"juno":              "m/44'/118'/0'/0/0",
+	"cosmos":            "m/44'/118'/0'/0/0",
+	types.SnapshotChain: "m/44'/118'/0'/0/0",
+	"solana":            "m/44'/501'/0'/0'",
+	"aptos":             "m/44'/637'/0'/0'/0'",
+	"sui":               "m/44'/784'/0'/0'/0'",
+}
+
+// bitcoinAddressTypes maps the bitcoin address types to their index in keeper.BitcoinAddresses,
+// the BIP-137 header of their signatures and the derivation path of their wallets
+var bitcoinAddressTypes = map[string]struct {
+	index  int
+	header byte
+	hdPath string
+}{
+	BitcoinP2PKH:      {0, 31, "m/44'/0'/0'/0/0"},
+	BitcoinP2SHP2WPKH: {1, 35, "m/49'/0'/0'/0/0"},
+	BitcoinP2WPKH:     {2, 39, "m/84'/0'/0'/0/0"},
+}
+
+// SignedClaim is the output of sign-claim, the pub key and signature are set when a key is given
+type SignedClaim struct {
+	Chain         string `json:"chain"`
+	Address       string `json:"address"`
+	RewardAddress string `json:"reward_address"`
+	SignMessage   string `json:"sign_message"`
+	SignDoc       string `json:"sign_doc,omitempty"`
+	PubKey        string `json:"pub_key,omitempty"`
+	Signature     string `json:"signature,omitempty"`
+}
+
+// claimKey signs claims of the native chain address derived from a local key
+type claimKey struct {
+	address string
+	pubKey  string
+	sign    func(signBytes []byte, rewardAddr string) (string, error)
+}
+
+// GetTxSignClaimCmd returns the command building and signing the sign message of a claim
+func GetTxSignClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-claim [chain] [reward_address]",
+		Short: "Build and sign the sign message of a claim",
+		Long: `Build the sign message of a claim of the allocation of a native chain address to the reward address, for the
+--chain-id and --campaign-id. The sign message is signed with the hex --private-key or the --mnemonic of the native
+chain account, derived at --hd-path or the default path of the chain wallets, and the pub_key and signature to pass to
+claim-allocation are printed. Without a key the sign message of the --address is printed, for ADR-036 chains along
+with the amino sign doc signed by wallets. --legacy builds the legacy sign message.`,
+		Example: fmt.Sprintf(`$ %s tx airdrop sign-claim cosmos furya1... --mnemonic="..." --chain-id=furya-1 --campaign-id=1
+$ %s tx airdrop sign-claim bitcoin furya1... --private-key=0x... --bitcoin-address-type=p2wpkh --chain-id=furya-1
+$ %s tx airdrop sign-claim evm furya1... --address=0x... --chain-id=furya-1`, version.AppName, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chain := args[0]
+			rewardAddr := args[1]
+			if keeper.GetSignatureVerifier(chain) == nil {
+				return fmt.Errorf("unsupported chain %s, supported chains are %v", chain, keeper.SupportedChains())
+			}
+			if _, err := sdk.AccAddressFromBech32(rewardAddr); err != nil {
+				return err
+			}
+
+			key, err := loadClaimKey(cmd, chain)
+			if err != nil {
+				return err
+			}
+			address, _ := cmd.Flags().GetString(FlagAddress)
+			if key != nil {
+				if address != "" && types.NormalizeAddress(address) != types.NormalizeAddress(key.address) {
+					return fmt.Errorf("the key derives %s, not %s", key.address, address)
+				}
+				address = key.address
+			}
+			if address == "" {
+				return fmt.Errorf("--%s is required without a key", FlagAddress)
+			}
+			// allocations are stored under the normalized address, which is part of the sign message
+			address = types.NormalizeAddress(address)
+
+			var signBytes []byte
+			legacy, _ := cmd.Flags().GetBool(FlagLegacy)
+			if legacy {
+				signBytes, err = keeper.GetLegacySignBytes(chain, address, rewardAddr)
+			} else {
+				if clientCtx.ChainID == "" {
+					return fmt.Errorf("--%s is required", flags.FlagChainID)
+				}
+				campaignId, _ := cmd.Flags().GetUint64(FlagCampaignId)
+				signBytes, err = keeper.GetSignBytes(clientCtx.ChainID, campaignId, chain, address, rewardAddr)
+			}
+			if err != nil {
+				return err
+			}
+
+			signed := SignedClaim{
+				Chain:         chain,
+				Address:       address,
+				RewardAddress: rewardAddr,
+				SignMessage:   string(signBytes),
+			}
+			_, adr036 := adr036Prefixes[chain]
+			_, ethAdr036 := ethAdr036Prefixes[chain]
+			if adr036 || ethAdr036 {
+				signed.SignDoc = string(keeper.ADR036SignBytes(address, signBytes))
+			}
+
+			if key != nil {
+				signed.PubKey = key.pubKey
+				signed.Signature, err = key.sign(signBytes, rewardAddr)
+				if err != nil {
+					return err
+				}
+				err = keeper.VerifySignature(chain, address, signed.PubKey, rewardAddr, signed.Signature, signBytes)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.Marshal(signed)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bz)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Native chain address, checked against the address derived from the key if one is given")
+	cmd.Flags().String(FlagPrivateKey, "", "Hex private key of the native chain account, an ed25519 seed for solana, aptos and sui")
+	cmd.Flags().String(FlagMnemonic, "", "Mnemonic of the native chain account")
+	cmd.Flags().String(FlagHDPath, "", "Derivation path of the mnemonic, the default path of the chain wallets by default")
+	cmd.Flags().String(FlagBitcoinAddressType, BitcoinP2WPKH, "Bitcoin address type of the key: p2pkh, p2sh-p2wpkh or p2wpkh")
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the allocation")
+	cmd.Flags().Bool(FlagLegacy, false, "Build the legacy sign message, accepted until the legacy sign message deadline")
+	cmd.Flags().String(flags.FlagChainID, "", "The furya network chain ID")
+
+	return cmd
+}
+
+// loadClaimKey returns the key of the --private-key or --mnemonic flag, nil if neither is set
+func loadClaimKey(cmd *cobra.Command, chain string) (*claimKey, error) {
+	privKeyHex, _ := cmd.Flags().GetString(FlagPrivateKey)
+	mnemonic, _ := cmd.Flags().GetString(FlagMnemonic)
+	if privKeyHex == "" && mnemonic == "" {
+		return nil, nil
+	}
+	if privKeyHex != "" && mnemonic != "" {
+		return nil, fmt.Errorf("--%s and --%s are exclusive", FlagPrivateKey, FlagMnemonic)
+	}
+
+	hdPath, _ := cmd.Flags().GetString(FlagHDPath)
+	if hdPath == "" {
+		hdPath = defaultHDPaths[chain]
+	}
+	bitcoinType, _ := cmd.Flags().GetString(FlagBitcoinAddressType)
+	bitcoinAddrType, ok := bitcoinAddressTypes[bitcoinType]
+	if chain == "bitcoin" {
+		if !ok {
+			return nil, fmt.Errorf("invalid bitcoin address type %s", bitcoinType)
+		}
+		if !cmd.Flags().Changed(FlagHDPath) {
+			hdPath = bitcoinAddrType.hdPath
+		}
+	}
+
+	switch chain {
+	case "solana", "aptos", "sui":
+		var seed []byte
+		var err error
+		if mnemonic != "" {
+			seed, err = deriveEd25519Seed(mnemonic, hdPath)
+		} else {
+			seed, err = hexutil.Decode(ensureHexPrefix(privKeyHex))
+			if err == nil && len(seed) == ed25519.PrivateKeySize {
+				seed = seed[:ed25519.SeedSize]
+			}
+			if err == nil && len(seed) != ed25519.SeedSize {
+				err = fmt.Errorf("expected a %d bytes ed25519 seed", ed25519.SeedSize)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ed25519ClaimKey(chain, ed25519.NewKeyFromSeed(seed)), nil
+	}
+
+	var privKeyBytes []byte
+	var err error
+	if mnemonic != "" {
+		privKeyBytes, err = hd.Secp256k1.Derive()(mnemonic, "", hdPath)
+	} else {
+		privKeyBytes, err = hexutil.Decode(ensureHexPrefix(privKeyHex))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1ClaimKey(chain, privKeyBytes, bitcoinAddrType.index, bitcoinAddrType.header)
+}
+
+func ensureHexPrefix(s string) string {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+	return "0x" + s
+}
+
+// secp256k1ClaimKey returns the claim key of a secp256k1 private key for the chain
+func secp256k1ClaimKey(chain string, privKeyBytes []byte, bitcoinIndex int, bitcoinHeader byte) (*claimKey, error) {
+	ecdsaKey, err := crypto.ToECDSA(privKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	privKey := &secp256k1.PrivKey{Key: privKeyBytes}
+	compressedPubKey := crypto.CompressPubkey(&ecdsaKey.PublicKey)
+
+	if prefix, ok := adr036Prefixes[chain]; ok {
+		address, err := bech32.ConvertAndEncode(prefix, privKey.PubKey().Address())
+		if err != nil {
+			return nil, err
+		}
+		return &claimKey{
+			address: address,
+			pubKey:  hexutil.Encode(privKey.PubKey().Bytes()),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature, err := privKey.Sign(keeper.ADR036SignBytes(address, signBytes))
+				return hexutil.Encode(signature), err
+			},
+		}, nil
+	}
+
+	if prefix, ok := ethAdr036Prefixes[chain]; ok {
+		address, err := bech32.ConvertAndEncode(prefix, crypto.PubkeyToAddress(ecdsaKey.PublicKey).Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return &claimKey{
+			address: address,
+			pubKey:  hexutil.Encode(compressedPubKey),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature, err := crypto.Sign(crypto.Keccak256(keeper.ADR036SignBytes(address, signBytes)), ecdsaKey)
+				if err != nil {
+					return "", err
+				}
+				return hexutil.Encode(signature[:crypto.RecoveryIDOffset]), nil
+			},
+		}, nil
+	}
+
+	switch chain {
+	case "terra":
+		address, err := bech32.ConvertAndEncode("terra", privKey.PubKey().Address())
+		if err != nil {
+			return nil, err
+		}
+		return &claimKey{
+			address: address,
+			pubKey:  hexutil.Encode(privKey.PubKey().Bytes()),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature, err := privKey.Sign(signBytes)
+				return hexutil.Encode(signature), err
+			},
+		}, nil
+	case "evm":
+		return &claimKey{
+			address: crypto.PubkeyToAddress(ecdsaKey.PublicKey).Hex(),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature, err := crypto.Sign(accounts.TextHash(signBytes), ecdsaKey)
+				if err != nil {
+					return "", err
+				}
+				signature[crypto.RecoveryIDOffset] += 27
+				return hexutil.Encode(signature), nil
+			},
+		}, nil
+	case "bitcoin":
+		return &claimKey{
+			address: keeper.BitcoinAddresses(compressedPubKey)[bitcoinIndex],
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature, err := crypto.Sign(keeper.BitcoinMessageHash(signBytes), ecdsaKey)
+				if err != nil {
+					return "", err
+				}
+				// go-ethereum signatures are [R || S || V] whereas compact signatures are [V || R || S]
+				compact := append([]byte{bitcoinHeader + signature[crypto.RecoveryIDOffset]}, signature[:crypto.RecoveryIDOffset]...)
+				return base64.StdEncoding.EncodeToString(compact), nil
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("signing %s claims with a secp256k1 key is not supported, sign the sign message with the chain wallet", chain)
+}
+
+// ed25519ClaimKey returns the claim key of an ed25519 private key for the chain
+func ed25519ClaimKey(chain string, privKey ed25519.PrivateKey) *claimKey {
+	pubKey := privKey.Public().(ed25519.PublicKey)
+	switch chain {
+	case "solana":
+		return &claimKey{
+			address: solana.PublicKeyFromBytes(pubKey).String(),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				return "0x" + hex.EncodeToString(ed25519.Sign(privKey, signBytes)), nil
+			},
+		}
+	case "aptos":
+		return &claimKey{
+			address: keeper.AptosAddress(pubKey),
+			pubKey:  hexutil.Encode(pubKey),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				return hexutil.Encode(ed25519.Sign(privKey, keeper.AptosFullMessage(signBytes, rewardAddr))), nil
+			},
+		}
+	default: // sui
+		return &claimKey{
+			address: keeper.SuiAddress(pubKey),
+			pubKey:  hexutil.Encode(pubKey),
+			sign: func(signBytes []byte, rewardAddr string) (string, error) {
+				signature := ed25519.Sign(privKey, keeper.SuiPersonalMessageDigest(signBytes))
+				return hexutil.Encode(append(append([]byte{0x00}, signature...), pubKey...)), nil
+			},
+		}
+	}
+}
+
+// deriveEd25519Seed derives the ed25519 seed of a mnemonic at a hardened path following SLIP-0010,
+// as solana, aptos and sui wallets do.
+func deriveEd25519Seed(mnemonic string, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	return slip10DeriveEd25519(seed, hdPath)
+}
+
+func slip10DeriveEd25519(seed []byte, hdPath string) ([]byte, error) {
+	mac := hmac.New(sha512.New, []byte("ed25519 seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	parts := strings.Split(strings.TrimPrefix(hdPath, "m/"), "/")
+	for _, part := range parts {
+		if !strings.HasSuffix(part, "'") {
+			return nil, fmt.Errorf("invalid ed25519 path %s, every index must be hardened", hdPath)
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ed25519 path %s: %w", hdPath, err)
+		}
+
+		indexBytes := make([]byte, 4)
+		binary.BigEndian.PutUint32(indexBytes, uint32(index)|0x80000000)
+		data := append(append([]byte{0x00}, key...), indexBytes...)
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum = mac.Sum(nil)
+		key, chainCode = sum[:32], sum[32:]
+	}
+	return key, nil
+}
@@ -2,15 +2,37 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/furysport/fury-chain/x/airdrop/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagMerkleAirdropFile = "merkle-airdrop-file"
+	FlagMerkleAirdropId   = "merkle-airdrop-id"
+	FlagCampaignId        = "campaign-id"
+	FlagStartTime         = "start-time"
+	FlagEndTime           = "end-time"
+	FlagPubKey            = "pub-key"
+	FlagAmount            = "amount"
+	FlagDestinations      = "destinations"
+	FlagValidator         = "validator"
+	FlagDelegatePercent   = "delegate-percentage"
+	FlagVestingType       = "vesting-type"
+	FlagVestingDuration   = "vesting-duration"
+	FlagVestingPeriods    = "vesting-periods"
+	FlagBatchSize         = "batch-size"
+	FlagRecipient         = "recipient"
+	FlagSkipSigCheck      = "skip-signature-check"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -23,11 +45,20 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetTxClaimAllocationCmd(),
+		GetTxSignClaimCmd(),
 		GetTxSetAllocationCmd(),
+		GetTxSetAllocationsCmd(),
+		GetTxTransferModuleOwnership(),
+		GetTxAcceptModuleOwnershipCmd(),
 		GetTxDepositTokensCmd(),
+		GetTxWithdrawTokensCmd(),
+		GetTxRevokeAllocationCmd(),
 		AllocateFurtherAirdropCmd(),
 		FetchAndRemoveAirdropCmd(),
 		AllocateStarsAirdropCmd(),
+		BuildMerkleAirdropCmd(),
+		GetTxCreateMerkleAirdropCmd(),
+		GetTxCreateCampaignCmd(),
 	)
 
 	return txCmd
@@ -38,7 +69,13 @@ func GetTxClaimAllocationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim-allocation [native_chain_address] [signature]",
 		Short: "Claim reward allocation",
-		Args:  cobra.ExactArgs(2),
+		Long: `Claim reward allocation to the --from address.
+The whole claimable amount is claimed unless --amount is set, --destinations splits the claim across furya addresses
+and --delegate-percentage delegates a part of what the --from address receives to --validator.
+The signature of a first claim is verified against the on-chain allocation before broadcasting, a rejected
+signature is reported with what to fix.`,
+		Example: fmt.Sprintf(`$ %s tx airdrop claim-allocation cosmos1... 0x... --pub-key=0x... --amount=100ufury --destinations=furya1...=60ufury,furya1...=40ufury --validator=furyavaloper1... --delegate-percentage=0.5 --from=mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 
@@ -48,21 +85,102 @@ func GetTxClaimAllocationCmd() *cobra.Command {
 				args[1],
 			)
 
+			err = parseClaimFlags(cmd, msg)
+			if err != nil {
+				return err
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
 
+			skipSigCheck, _ := cmd.Flags().GetBool(FlagSkipSigCheck)
+			if !skipSigCheck && !clientCtx.Offline {
+				err = verifyClaimSignature(clientCtx, msg)
+				if err != nil {
+					return err
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagSkipSigCheck, false, "Broadcast without verifying the signature against the on-chain allocation first")
+	addClaimFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
 }
 
+// addClaimFlags adds the flags of the optional fields of MsgClaimAllocation
+func addClaimFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the allocation, zero for the module owner pool")
+	cmd.Flags().String(FlagMerkleAirdropFile, "", "Merkle airdrop file built by build-merkle-airdrop, for allocations not stored on-chain")
+	cmd.Flags().Uint64(FlagMerkleAirdropId, 0, "Id of the merkle airdrop to claim from")
+	cmd.Flags().String(FlagPubKey, "", "Hex public key of the native chain address, for chains verifying signatures against it")
+	cmd.Flags().String(FlagAmount, "", "Amount to claim, the whole claimable amount by default")
+	cmd.Flags().StringSlice(FlagDestinations, []string{}, "Comma separated address=amount destinations splitting the claim")
+	cmd.Flags().String(FlagValidator, "", "Validator to delegate to")
+	cmd.Flags().String(FlagDelegatePercent, "", "Fraction of the amount received by the --from address to delegate, e.g. 0.5")
+}
+
+// parseClaimFlags sets the optional fields of the claim from the flags added by addClaimFlags
+func parseClaimFlags(cmd *cobra.Command, msg *types.MsgClaimAllocation) error {
+	msg.CampaignId, _ = cmd.Flags().GetUint64(FlagCampaignId)
+
+	merkleFile, _ := cmd.Flags().GetString(FlagMerkleAirdropFile)
+	if merkleFile != "" {
+		file, err := readMerkleAirdropFile(merkleFile)
+		if err != nil {
+			return err
+		}
+		claim, ok := file.Claims[msg.Address]
+		if !ok {
+			return fmt.Errorf("no merkle claim for %s in %s", msg.Address, merkleFile)
+		}
+		claim.AirdropId, _ = cmd.Flags().GetUint64(FlagMerkleAirdropId)
+		msg.MerkleClaim = &claim
+	}
+
+	msg.PubKey, _ = cmd.Flags().GetString(FlagPubKey)
+
+	amountStr, _ := cmd.Flags().GetString(FlagAmount)
+	if amountStr != "" {
+		amount, err := sdk.ParseCoinNormalized(amountStr)
+		if err != nil {
+			return err
+		}
+		msg.Amount = &amount
+	}
+
+	destinations, _ := cmd.Flags().GetStringSlice(FlagDestinations)
+	for _, destination := range destinations {
+		parts := strings.SplitN(destination, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid destination %s, expected address=amount", destination)
+		}
+		amount, err := sdk.ParseCoinNormalized(parts[1])
+		if err != nil {
+			return err
+		}
+		msg.Destinations = append(msg.Destinations, types.ClaimDestination{Address: parts[0], Amount: amount})
+	}
+
+	msg.ValidatorAddress, _ = cmd.Flags().GetString(FlagValidator)
+	delegatePercent, _ := cmd.Flags().GetString(FlagDelegatePercent)
+	if delegatePercent != "" {
+		delegatePercentage, err := sdk.NewDecFromStr(delegatePercent)
+		if err != nil {
+			return err
+		}
+		msg.DelegatePercentage = delegatePercentage
+	}
+	return nil
+}
+
 // GetTxSetAllocationCmd implement cli command for MsgSetAllocation
 func GetTxSetAllocationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -82,6 +200,11 @@ func GetTxSetAllocationCmd() *cobra.Command {
 				return err
 			}
 
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetAllocation(
 				clientCtx.FromAddress.String(),
 				types.AirdropAllocation{
@@ -89,6 +212,7 @@ func GetTxSetAllocationCmd() *cobra.Command {
 					Address:       args[1],
 					Amount:        amount,
 					ClaimedAmount: claimedAmount,
+					CampaignId:    campaignId,
 				},
 			)
 
@@ -101,16 +225,18 @@ func GetTxSetAllocationCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign funding the allocation, zero for the module owner pool")
 	flags.AddTxFlagsToCmd(cmd)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
 }
 
+// GetTxTransferModuleOwnership implement cli command for MsgTransferModuleOwnership
 func GetTxTransferModuleOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "transfer-module-ownership [newOwner] [flags]",
-		Long: "Transfer module ownership to new address",
+		Long: "Propose a new module owner, which takes over once it accepts the ownership. An empty new owner cancels the pending transfer.",
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -136,6 +262,35 @@ func GetTxTransferModuleOwnership() *cobra.Command {
 	return cmd
 }
 
+// GetTxAcceptModuleOwnershipCmd implement cli command for MsgAcceptModuleOwnership
+func GetTxAcceptModuleOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "accept-module-ownership [flags]",
+		Long: "Accept the module ownership proposed to the sender",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptModuleOwnership(
+				clientCtx.GetFromAddress(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetTxDepositTokensCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "deposit-tokens [amount] [flags]",
@@ -151,9 +306,15 @@ func GetTxDepositTokensCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDepositTokens(
 				clientCtx.GetFromAddress(),
 				amount,
+				campaignId,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -164,7 +325,186 @@ func GetTxDepositTokensCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign to fund, zero for the module owner pool")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// GetTxWithdrawTokensCmd implement cli command for MsgWithdrawTokens
+func GetTxWithdrawTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "withdraw-tokens [amount] [flags]",
+		Long: "Withdraw tokens of a campaign not reserved by its allocations",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokens(
+				clientCtx.GetFromAddress(),
+				campaignId,
+				amount,
+				recipient,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign to withdraw from, zero for the module owner pool")
+	cmd.Flags().String(FlagRecipient, "", "Address receiving the tokens, the sender by default")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxRevokeAllocationCmd implement cli command for MsgRevokeAllocation
+func GetTxRevokeAllocationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "revoke-allocation [address] [flags]",
+		Long: "Revoke the unclaimed amount of an allocation of a campaign owned by the sender",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignId, err := cmd.Flags().GetUint64(FlagCampaignId)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAllocation(
+				clientCtx.GetFromAddress(),
+				campaignId,
+				args[0],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCampaignId, 0, "Campaign of the allocation, zero for the module owner pool")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetTxCreateCampaignCmd implement cli command for MsgCreateCampaign
+func GetTxCreateCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign [denom] [flags]",
+		Short: "Create an airdrop campaign owned by the sender",
+		Long: `Create an airdrop campaign owned by the sender, with an optional claim window in RFC3339 format.
+Claimed amounts can vest in a continuous or periodic vesting account of the receiver.
+Example:
+	furyad tx airdrop create-campaign ufury --start-time=2023-01-01T00:00:00Z --end-time=2023-07-01T00:00:00Z --from=partner
+	furyad tx airdrop create-campaign ufury --vesting-type=periodic --vesting-duration=8760h --vesting-periods=12 --from=partner
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+			endTime, err := parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+			vesting, err := parseVestingFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCampaign(
+				clientCtx.GetFromAddress(),
+				args[0],
+				startTime,
+				endTime,
+				vesting,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "Start of the claim window in RFC3339 format")
+	cmd.Flags().String(FlagEndTime, "", "End of the claim window in RFC3339 format, never ends if empty")
+	cmd.Flags().String(FlagVestingType, "none", "Vesting of the claimed amounts: none, continuous or periodic")
+	cmd.Flags().Duration(FlagVestingDuration, 0, "Duration over which the claimed amounts vest")
+	cmd.Flags().Uint32(FlagVestingPeriods, 0, "Number of equal vesting periods of periodic vesting")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func parseVestingFlags(cmd *cobra.Command) (types.VestingParams, error) {
+	vestingType, err := cmd.Flags().GetString(FlagVestingType)
+	if err != nil {
+		return types.VestingParams{}, err
+	}
+	duration, err := cmd.Flags().GetDuration(FlagVestingDuration)
+	if err != nil {
+		return types.VestingParams{}, err
+	}
+	numPeriods, err := cmd.Flags().GetUint32(FlagVestingPeriods)
+	if err != nil {
+		return types.VestingParams{}, err
+	}
+
+	params := types.VestingParams{Duration: duration, NumPeriods: numPeriods}
+	switch vestingType {
+	case "", "none":
+		params.Type = types.VestingNone
+	case "continuous":
+		params.Type = types.VestingContinuous
+	case "periodic":
+		params.Type = types.VestingPeriodic
+	default:
+		return types.VestingParams{}, fmt.Errorf("invalid vesting type: %s", vestingType)
+	}
+	return params, nil
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, value)
+}
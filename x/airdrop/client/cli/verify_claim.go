@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// verifyClaimSignature verifies the signature of a first claim against the allocation stored on-chain
+// before broadcasting, so that a rejected signature is explained without paying fees. Claims of
+// allocations not stored on-chain yet, e.g. merkle claims, and later claims are not checked.
+func verifyClaimSignature(clientCtx client.Context, msg *types.MsgClaimAllocation) error {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Allocation(context.Background(), &types.QueryAllocationRequest{Address: msg.Address, CampaignId: msg.CampaignId})
+	if err != nil {
+		return err
+	}
+	if res.Allocation == nil {
+		return nil
+	}
+	record, err := queryClient.ClaimRecord(context.Background(), &types.QueryClaimRecordRequest{Address: msg.Address, CampaignId: msg.CampaignId})
+	if err != nil {
+		return err
+	}
+	if record.ClaimRecord != nil {
+		return nil
+	}
+
+	// legacy signatures are accepted until the legacy sign message deadline, which the chain enforces
+	allocation := *res.Allocation
+	err = keeper.VerifyClaimSignBytes(clientCtx.ChainID, allocation, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature, true)
+	return explainSignatureError(err, allocation, msg.RewardAddress, clientCtx.ChainID)
+}
+
+// explainSignatureError appends to a signature verification error what the claimer has to fix
+func explainSignatureError(err error, allocation types.AirdropAllocation, rewardAddr string, chainId string) error {
+	var hint string
+	switch {
+	case err == nil:
+		return nil
+	case sdkerrors.IsOf(err, types.ErrUnsupportedChain):
+		hint = fmt.Sprintf("allocations of chain %q cannot be claimed, supported chains are %v", allocation.Chain, keeper.SupportedChains())
+	case sdkerrors.IsOf(err, types.ErrInvalidPubKey):
+		hint = fmt.Sprintf("--%s must be the hex encoded public key of %s", FlagPubKey, allocation.Address)
+	case sdkerrors.IsOf(err, types.ErrPubKeyAddressMismatch):
+		hint = fmt.Sprintf("--%s is not the public key of %s, export the public key of the account holding the allocation", FlagPubKey, allocation.Address)
+	case sdkerrors.IsOf(err, types.ErrInvalidSignatureFormat):
+		hint = fmt.Sprintf("the signature is not encoded as the %s wallets produce it", allocation.Chain)
+	case sdkerrors.IsOf(err, types.ErrSignatureMismatch):
+		hint = fmt.Sprintf("%s must sign the sign message of chain id %s, campaign %d and reward address %s",
+			allocation.Address, chainId, allocation.CampaignId, rewardAddr)
+	default:
+		return err
+	}
+	return fmt.Errorf("%w\n%s", err, hint)
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/furysport/fury-chain/x/airdrop/client/cli"
+)
+
+// OwnerMsgsProposalHandler is the gov client handler of airdrop owner msgs proposals
+var OwnerMsgsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitOwnerMsgsProposal, emptyRestHandler)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-airdrop-owner-msgs",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for airdrop owner proposals")
+		},
+	}
+}
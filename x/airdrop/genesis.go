@@ -14,12 +14,51 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, allocation := range genState.Allocations {
 		k.SetAllocation(ctx, allocation)
 	}
+	for _, record := range genState.ClaimRecords {
+		k.SetClaimRecord(ctx, record)
+	}
+	for _, airdrop := range genState.MerkleAirdrops {
+		k.SetMerkleAirdrop(ctx, airdrop)
+		if airdrop.Id > k.GetLastMerkleAirdropId(ctx) {
+			k.SetLastMerkleAirdropId(ctx, airdrop.Id)
+		}
+	}
+	for _, word := range genState.MerkleClaimedWords {
+		k.SetMerkleClaimedWord(ctx, word)
+	}
+	for _, campaign := range genState.Campaigns {
+		k.SetCampaign(ctx, campaign)
+		if campaign.Id > k.GetLastCampaignId(ctx) {
+			k.SetLastCampaignId(ctx, campaign.Id)
+		}
+	}
+	for _, upload := range genState.AllocationUploads {
+		k.SetAllocationUpload(ctx, upload)
+	}
+	k.SetPendingOwner(ctx, genState.PendingOwner)
+	for _, snapshot := range genState.Snapshots {
+		k.SetSnapshot(ctx, snapshot)
+	}
+	for _, receipt := range genState.ClaimReceipts {
+		k.SetClaimReceipt(ctx, receipt)
+		if receipt.Id > k.GetLastClaimReceiptId(ctx) {
+			k.SetLastClaimReceiptId(ctx, receipt.Id)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:      k.GetParamSet(ctx),
-		Allocations: k.GetAllAllocations(ctx),
+		Params:             k.GetParamSet(ctx),
+		Allocations:        k.GetAllAllocations(ctx),
+		ClaimRecords:       k.GetAllClaimRecords(ctx),
+		MerkleAirdrops:     k.GetAllMerkleAirdrops(ctx),
+		MerkleClaimedWords: k.GetAllMerkleClaimedWords(ctx),
+		Campaigns:          k.GetAllCampaigns(ctx),
+		AllocationUploads:  k.GetAllAllocationUploads(ctx),
+		PendingOwner:       k.GetPendingOwner(ctx),
+		Snapshots:          k.GetAllSnapshots(ctx),
+		ClaimReceipts:      k.GetAllClaimReceipts(ctx),
 	}
 }
@@ -1,16 +1,18 @@
 package keeper
 
 import (
+	appparams "github.com/furysport/fury-chain/app/params"
 	"github.com/furysport/fury-chain/x/airdrop/types"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-func (k Keeper) GetAllocation(ctx sdk.Context, address string) *types.AirdropAllocation {
+// GetAllocation returns the allocation of the address in the campaign, looked up by its normalized form
+func (k Keeper) GetAllocation(ctx sdk.Context, campaignId uint64, address string) *types.AirdropAllocation {
 	allocation := types.AirdropAllocation{}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
-	bz := prefixStore.Get([]byte(address))
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAllocationKey(campaignId, types.NormalizeAddress(address)))
 	if bz == nil {
 		return nil
 	}
@@ -33,59 +35,238 @@ func (k Keeper) GetAllAllocations(ctx sdk.Context) []types.AirdropAllocation {
 	return allocations
 }
 
+// SetAllocation stores the allocation under its campaign and normalized address, updating the amount
+// reserved by allocations of its campaign
 func (k Keeper) SetAllocation(ctx sdk.Context, allocation types.AirdropAllocation) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
+	allocation.Address = types.NormalizeAddress(allocation.Address)
+	if prev := k.GetAllocation(ctx, allocation.CampaignId, allocation.Address); prev != nil {
+		k.addReservedAmount(ctx, prev.CampaignId, prev.Amount.Denom, unclaimedAmount(*prev).Neg())
+	}
+	k.addReservedAmount(ctx, allocation.CampaignId, allocation.Amount.Denom, unclaimedAmount(allocation))
+
 	bz := k.cdc.MustMarshal(&allocation)
-	prefixStore.Set([]byte(allocation.Address), bz)
+	ctx.KVStore(k.storeKey).Set(types.GetAllocationKey(allocation.CampaignId, allocation.Address), bz)
 }
 
-func (k Keeper) DeleteAllocation(ctx sdk.Context, address string) {
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAirdropAllocation)
-	prefixStore.Delete([]byte(address))
+// DeleteAllocation deletes the allocation, releasing its unclaimed amount from its campaign
+func (k Keeper) DeleteAllocation(ctx sdk.Context, campaignId uint64, address string) {
+	if prev := k.GetAllocation(ctx, campaignId, address); prev != nil {
+		k.addReservedAmount(ctx, prev.CampaignId, prev.Amount.Denom, unclaimedAmount(*prev).Neg())
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetAllocationKey(campaignId, types.NormalizeAddress(address)))
 }
 
-func (k Keeper) ClaimAllocation(ctx sdk.Context, address string, pubKey string, rewardAddress string, signature string) error {
+// EnsureAllocationFunded returns an error if the unallocated balance of the campaign does not cover
+// the amount the allocation reserves on top of the allocation it replaces.
+func (k Keeper) EnsureAllocationFunded(ctx sdk.Context, allocation types.AirdropAllocation) error {
+	reserved := unclaimedAmount(allocation)
+	if prev := k.GetAllocation(ctx, allocation.CampaignId, allocation.Address); prev != nil && prev.Amount.Denom == allocation.Amount.Denom {
+		reserved = reserved.Sub(unclaimedAmount(*prev))
+	}
+	if !reserved.IsPositive() {
+		return nil
+	}
+	return k.EnsureUnallocated(ctx, allocation.CampaignId, sdk.NewCoin(allocation.Amount.Denom, reserved))
+}
+
+// ClaimAllocation claims an airdrop allocation to the reward address or the claim destinations.
+// The first claim verifies the native chain account signature, records the reward address and unlocks
+// the initial claim tranche. Later claims from the same reward address withdraw what is left of the
+// unlocked amount, ClaimedAmount tracks the cumulative claimed amount.
+func (k Keeper) ClaimAllocation(ctx sdk.Context, msg types.MsgClaimAllocation) error {
 	// ensure allocation exists for the address
-	allocation := k.GetAllocation(ctx, address)
+	allocation := k.GetAllocation(ctx, msg.CampaignId, msg.Address)
 	if allocation == nil {
 		return types.ErrAirdropAllocationDoesNotExists
 	}
 
-	// ensure allocation is not claimed already
-	unclaimed := allocation.Amount.Sub(allocation.ClaimedAmount)
-	if unclaimed.IsZero() {
+	record := k.GetClaimRecord(ctx, msg.CampaignId, msg.Address)
+	if record == nil {
+		// ensure allocation is not claimed already
+		unclaimed := allocation.Amount.Sub(allocation.ClaimedAmount)
+		if unclaimed.IsZero() {
+			return types.ErrAirdropAllocationAlreadyClaimed
+		}
+
+		// verify native chain account with signature, the error tells why the signature is rejected
+		if err := k.VerifyClaimSignature(ctx, *allocation, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature); err != nil {
+			return err
+		}
+
+		// ensure reward address is valid before recording the claim
+		if _, err := sdk.AccAddressFromBech32(msg.RewardAddress); err != nil {
+			return err
+		}
+
+		// record the claim and unlock the initial claim tranche
+		record = &types.ClaimRecord{
+			Address:         allocation.Address,
+			RewardAddress:   msg.RewardAddress,
+			ActionCompleted: make([]bool, len(types.Action_name)),
+			StakeFraction:   sdk.ZeroDec(),
+			CampaignId:      allocation.CampaignId,
+		}
+		unlocked := k.ClaimableForAction(*allocation, *record, types.ActionInitialClaim)
+		record.ActionCompleted[types.ActionInitialClaim] = true
+		k.SetClaimRecord(ctx, *record)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteAction,
+				sdk.NewAttribute(types.AttributeKeyAddress, record.Address),
+				sdk.NewAttribute(types.AttributeKeyRewardAddress, record.RewardAddress),
+				sdk.NewAttribute(types.AttributeKeyAction, types.ActionInitialClaim.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, unlocked.String()),
+			),
+		)
+	} else if record.RewardAddress != msg.RewardAddress {
 		return types.ErrAirdropAllocationAlreadyClaimed
 	}
 
-	// verify native chain account with signature
-	sigOk := VerifySignature(allocation.Chain, allocation.Address, pubKey, rewardAddress, signature)
-	if !sigOk {
-		return types.ErrNativeChainAccountSigVerificationFailure
+	// a claim with a validator sets the stake preference applied to this and later payouts
+	if msg.ValidatorAddress != "" {
+		record.ValidatorAddress = msg.ValidatorAddress
+		record.StakeFraction = sdk.ZeroDec()
+		if !msg.DelegatePercentage.IsNil() {
+			record.StakeFraction = msg.DelegatePercentage
+		}
+		k.SetClaimRecord(ctx, *record)
 	}
 
-	// send coins from airdrop module account to beneficiary address
-	sdkAddr, err := sdk.AccAddressFromBech32(rewardAddress)
-	if err != nil {
-		return err
+	// claim the whole claimable amount unless an amount or destinations are specified
+	claimable := k.ClaimableAmount(*allocation, *record)
+	amount := claimable
+	destinations := msg.Destinations
+	if msg.Amount != nil {
+		amount = *msg.Amount
+	} else if len(destinations) > 0 {
+		amount = types.ClaimDestinationsTotal(destinations)
+	}
+	if claimable.IsZero() {
+		return types.ErrAirdropAllocationAlreadyClaimed
+	}
+	if amount.Denom != allocation.Amount.Denom || !amount.IsPositive() {
+		return types.ErrInvalidClaimAmount
+	}
+	if claimable.IsLT(amount) {
+		return types.ErrInsufficientClaimableAmount
+	}
+	if len(destinations) == 0 {
+		destinations = []types.ClaimDestination{{Address: msg.RewardAddress, Amount: amount}}
+	}
+	if !types.ClaimDestinationsMatch(destinations, amount) {
+		return types.ErrInvalidClaimDestinations
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdkAddr, sdk.Coins{unclaimed})
+	err := k.SpendFromCampaign(ctx, allocation.CampaignId, amount)
 	if err != nil {
 		return err
 	}
 
-	// update claimed amount and set the record on-chain
-	allocation.ClaimedAmount = allocation.Amount
+	payout := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	for _, destination := range destinations {
+		addr, err := sdk.AccAddressFromBech32(destination.Address)
+		if err != nil {
+			return err
+		}
+		err = k.SendClaimedCoins(ctx, allocation.CampaignId, addr, sdk.Coins{destination.Amount})
+		if err != nil {
+			return err
+		}
+		k.RecordClaimReceipt(ctx, *allocation, msg.RewardAddress, destination.Address, destination.Amount)
+		if destination.Address == msg.RewardAddress {
+			payout = payout.Add(destination.Amount)
+		}
+	}
+
+	allocation.ClaimedAmount = allocation.ClaimedAmount.Add(amount)
 	k.SetAllocation(ctx, *allocation)
 
+	if record.ValidatorAddress != "" && !record.StakeFraction.IsNil() && record.StakeFraction.IsPositive() {
+		rewardAddr, err := sdk.AccAddressFromBech32(msg.RewardAddress)
+		if err != nil {
+			return err
+		}
+		err = k.DelegateClaim(ctx, rewardAddr, payout, record.ValidatorAddress, record.StakeFraction)
+		if err != nil {
+			return err
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeClaimAllocation,
-			sdk.NewAttribute(types.AttributeKeyAddress, address),
-			sdk.NewAttribute(types.AttributeKeyAmount, unclaimed.String()),
-			sdk.NewAttribute(types.AttributeKeyRewardAddress, rewardAddress),
+			sdk.NewAttribute(types.AttributeKeyAddress, allocation.Address),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, msg.RewardAddress),
 		),
 	)
 
+	return ctx.EventManager().EmitTypedEvent(&types.EventClaim{
+		Chain:         allocation.Chain,
+		Address:       allocation.Address,
+		CampaignId:    allocation.CampaignId,
+		RewardAddress: msg.RewardAddress,
+		Amount:        amount,
+		ClaimedAmount: allocation.ClaimedAmount,
+	})
+}
+
+// DelegateClaim delegates a percentage of the amount paid out to the delegator by a claim.
+func (k Keeper) DelegateClaim(ctx sdk.Context, delegator sdk.AccAddress, payout sdk.Coin, validatorAddr string, percentage sdk.Dec) error {
+	if payout.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return types.ErrInvalidDelegation
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	amount := percentage.MulInt(payout.Amount).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	projectedVotingPower := k.ProjectedVotingPower(ctx, validator, amount)
+	if projectedVotingPower.GTE(appparams.MaxVotingPower) {
+		return sdkerrors.Wrapf(
+			types.ErrMaxVotingPowerExceeded,
+			"validator post-delegation voting power %s%% is more than %s%%", projectedVotingPower, appparams.MaxVotingPower)
+	}
+
+	// the delegation is made by the module, it does not complete the delegate action of the claimer
+	_, err = k.stakingKeeper.Delegate(ctx.WithValue(autoDelegationKey{}, true), delegator, amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateClaim,
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(payout.Denom, amount).String()),
+		),
+	)
 	return nil
 }
+
+// ProjectedVotingPower returns the voting power of the validator after the delegation of amount
+// as a percentage, following the max voting power rule of the MinCommissionDecorator.
+func (k Keeper) ProjectedVotingPower(ctx sdk.Context, validator stakingtypes.ValidatorI, amount sdk.Int) sdk.Dec {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	bondedPool := k.acountKeeper.GetModuleAccount(ctx, stakingtypes.BondedPoolName)
+	notBondedPool := k.acountKeeper.GetModuleAccount(ctx, stakingtypes.NotBondedPoolName)
+	totalDelegatedTokens := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount.
+		Add(k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+
+	projectedValidatorTokens := sdk.NewDecFromInt(validator.GetTokens().Add(amount))
+	projectedTotalDelegatedTokens := sdk.NewDecFromInt(totalDelegatedTokens.Add(amount))
+	return projectedValidatorTokens.Quo(projectedTotalDelegatedTokens).Mul(sdk.NewDec(100))
+}
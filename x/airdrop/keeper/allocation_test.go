@@ -1,17 +1,25 @@
 package keeper_test
 
 import (
+	"strings"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
 	"github.com/furysport/fury-chain/x/airdrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 func (suite *KeeperTestSuite) TestAllocationGetSet() {
 	// get allocation for an address before set
-	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 	suite.Require().Nil(allocation)
 
 	allocations := suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
-	suite.Require().Len(allocations, 6)
+	suite.Require().Len(allocations, 0)
 
 	// set allocation
 	evmAllocation := types.AirdropAllocation{
@@ -22,19 +30,329 @@ func (suite *KeeperTestSuite) TestAllocationGetSet() {
 	}
 	suite.app.AirdropKeeper.SetAllocation(suite.ctx, evmAllocation)
 
-	// check allocation after set
-	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	// check allocation after set, stored under its checksummed address
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	evmAllocation.Address = "0x7Fc66500c84A76Ad7e9c93437bFc5Ac33E2DDaE9"
 	suite.Require().Equal(*allocation, evmAllocation)
 
 	allocations = suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
-	suite.Require().Len(allocations, 7)
+	suite.Require().Len(allocations, 1)
 
 	// check allocation after delete
-	suite.app.AirdropKeeper.DeleteAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	suite.app.AirdropKeeper.DeleteAllocation(suite.ctx, 0, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 
-	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9")
 	suite.Require().Nil(allocation)
 
 	allocations = suite.app.AirdropKeeper.GetAllAllocations(suite.ctx)
-	suite.Require().Len(allocations, 6)
+	suite.Require().Len(allocations, 0)
+}
+
+func (suite *KeeperTestSuite) TestPartialClaimAllocation() {
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	valAddr := suite.createValidator(1000000)
+	largeValAddr := suite.createValidator(100000000)
+
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin(bondDenom, 1000)})
+	suite.app.AirdropKeeper.SetAllocation(suite.ctx, types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin(bondDenom, 1000),
+		ClaimedAmount: sdk.NewInt64Coin(bondDenom, 0),
+	})
+
+	// claim part of the initial tranche split across two addresses and stake half of the reward address part
+	amount := sdk.NewInt64Coin(bondDenom, 100)
+	claimMsg := types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddr.String(),
+		Signature:     sign(rewardAddr.String(), 0),
+		Amount:        &amount,
+		Destinations: []types.ClaimDestination{
+			{Address: rewardAddr.String(), Amount: sdk.NewInt64Coin(bondDenom, 60)},
+			{Address: otherAddr.String(), Amount: sdk.NewInt64Coin(bondDenom, 40)},
+		},
+		ValidatorAddress:   largeValAddr.String(),
+		DelegatePercentage: sdk.NewDecWithPrec(5, 1),
+	}
+
+	// staking with a validator above the max voting power is rejected
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err := suite.app.AirdropKeeper.ClaimAllocation(cacheCtx, claimMsg)
+	suite.Require().ErrorIs(err, types.ErrMaxVotingPowerExceeded)
+
+	claimMsg.ValidatorAddress = valAddr.String()
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, claimMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(40), suite.app.BankKeeper.GetBalance(suite.ctx, otherAddr, bondDenom).Amount.Int64())
+
+	record := suite.app.AirdropKeeper.GetClaimRecord(suite.ctx, 0, cosmosAddr)
+	suite.Require().Equal(valAddr.String(), record.ValidatorAddress)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), record.StakeFraction)
+
+	// the delegation made by the claim does not complete the delegate action
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, rewardAddr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(30), delegation.Shares.TruncateInt64())
+	suite.Require().Equal(int64(30), suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, bondDenom).Amount.Int64())
+	suite.Require().False(suite.app.AirdropKeeper.GetClaimRecord(suite.ctx, 0, cosmosAddr).ActionCompleted[types.ActionDelegate])
+	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, cosmosAddr)
+	suite.Require().Equal(int64(100), allocation.ClaimedAmount.Amount.Int64())
+
+	// claims are bounded by the unlocked amount and limited to the recorded reward address
+	amount = sdk.NewInt64Coin(bondDenom, 200)
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		RewardAddress: rewardAddr.String(),
+		Amount:        &amount,
+	})
+	suite.Require().ErrorIs(err, types.ErrInsufficientClaimableAmount)
+
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: otherAddr.String(),
+		Signature:     sign(otherAddr.String(), 0),
+	})
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationAlreadyClaimed)
+
+	// the rest of the unlocked amount is claimed without a new signature, following the stake preference
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		RewardAddress: rewardAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(30+75), suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, bondDenom).Amount.Int64())
+	delegation, _ = suite.app.StakingKeeper.GetDelegation(suite.ctx, rewardAddr, valAddr)
+	suite.Require().Equal(int64(30+75), delegation.Shares.TruncateInt64())
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, cosmosAddr)
+	suite.Require().Equal(int64(250), allocation.ClaimedAmount.Amount.Int64())
+
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		RewardAddress: rewardAddr.String(),
+	})
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationAlreadyClaimed)
+
+	// an undelegation does not complete the delegate action
+	_, err = suite.app.StakingKeeper.Undelegate(suite.ctx, rewardAddr, valAddr, sdk.NewDec(5))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AirdropKeeper.GetClaimRecord(suite.ctx, 0, cosmosAddr).ActionCompleted[types.ActionDelegate])
+
+	// a delegation of the claimer completes the delegate action which pays out its tranche, half of it staked
+	validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, rewardAddr, sdk.NewInt(10), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AirdropKeeper.GetClaimRecord(suite.ctx, 0, cosmosAddr).ActionCompleted[types.ActionDelegate])
+	suite.Require().Equal(int64(105-10+125), suite.app.BankKeeper.GetBalance(suite.ctx, rewardAddr, bondDenom).Amount.Int64())
+	delegation, _ = suite.app.StakingKeeper.GetDelegation(suite.ctx, rewardAddr, valAddr)
+	suite.Require().Equal(int64(105-5+10+125), delegation.Shares.TruncateInt64())
+	allocation = suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, cosmosAddr)
+	suite.Require().Equal(int64(500), allocation.ClaimedAmount.Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestNormalizedAllocationAddresses() {
+	ctx := suite.ctx
+	ethPriv, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	evmAddr := crypto.PubkeyToAddress(ethPriv.PublicKey).String()
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+
+	// an allocation set with a lowercase address is found and claimed with the checksummed address
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	suite.app.AirdropKeeper.SetAllocation(ctx, types.AirdropAllocation{
+		Chain:         "evm",
+		Address:       strings.ToLower(evmAddr),
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+	allocation := suite.app.AirdropKeeper.GetAllocation(ctx, 0, evmAddr)
+	suite.Require().NotNil(allocation)
+	suite.Require().Equal(evmAddr, allocation.Address)
+
+	signBytes, err := keeper.GetSignBytes(testChainId, 0, "evm", evmAddr, rewardAddr)
+	suite.Require().NoError(err)
+	sig, err := crypto.Sign(accounts.TextHash(signBytes), ethPriv)
+	suite.Require().NoError(err)
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       strings.ToUpper(evmAddr[2:]),
+		RewardAddress: rewardAddr,
+		Signature:     hexutil.Encode(sig),
+	})
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationDoesNotExists)
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       "0x" + strings.ToUpper(evmAddr[2:]),
+		RewardAddress: rewardAddr,
+		Signature:     hexutil.Encode(sig),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(suite.app.AirdropKeeper.GetClaimRecord(ctx, 0, strings.ToLower(evmAddr)))
+	suite.Require().Equal(evmAddr, suite.app.AirdropKeeper.GetClaimRecord(ctx, 0, evmAddr).Address)
+
+	// the migration re-keys allocations and claim records stored before addresses were normalized
+	cdc := suite.app.AppCodec()
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	cosmosAddr := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	legacyAddr := strings.ToUpper(cosmosAddr)
+	store.Set(append(types.KeyPrefixAirdropAllocation, []byte(legacyAddr)...), cdc.MustMarshal(&types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       legacyAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 500),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+	}))
+	store.Set(append(types.KeyPrefixClaimRecord, []byte(legacyAddr)...), cdc.MustMarshal(&types.ClaimRecord{
+		Address:         legacyAddr,
+		RewardAddress:   rewardAddr,
+		ActionCompleted: make([]bool, len(types.Action_name)),
+		StakeFraction:   sdk.ZeroDec(),
+	}))
+	store.Set(append(types.GetClaimRecordByRewardAddressPrefix(sdk.MustAccAddressFromBech32(rewardAddr)), legacyAddr...), []byte{})
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, 0, legacyAddr))
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	suite.Require().False(store.Has(append(types.KeyPrefixAirdropAllocation, []byte(legacyAddr)...)))
+	suite.Require().Equal(cosmosAddr, suite.app.AirdropKeeper.GetAllocation(ctx, 0, legacyAddr).Address)
+	evmAllocation := suite.app.AirdropKeeper.GetAllocation(ctx, 0, evmAddr)
+	reserved := evmAllocation.Amount.Sub(evmAllocation.ClaimedAmount).AddAmount(sdk.NewInt(400))
+	suite.Require().Equal(reserved, suite.app.AirdropKeeper.GetReservedAmount(ctx, 0, "ufury"))
+	addresses := []string{}
+	for _, record := range suite.app.AirdropKeeper.GetClaimRecordsByRewardAddress(ctx, sdk.MustAccAddressFromBech32(rewardAddr)) {
+		addresses = append(addresses, record.Address)
+	}
+	suite.Require().ElementsMatch([]string{evmAddr, cosmosAddr}, addresses)
+
+	// wallets sign the address as the claimer submits it, which may not be the normalized address
+	lowerPriv, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	lowerAddr := strings.ToLower(crypto.PubkeyToAddress(lowerPriv.PublicKey).String())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	suite.app.AirdropKeeper.SetAllocation(ctx, types.AirdropAllocation{
+		Chain:         "evm",
+		Address:       lowerAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+	signBytes, err = keeper.GetSignBytes(testChainId, 0, "evm", lowerAddr, rewardAddr)
+	suite.Require().NoError(err)
+	sig, err = crypto.Sign(accounts.TextHash(signBytes), lowerPriv)
+	suite.Require().NoError(err)
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       "0x" + strings.ToUpper(lowerAddr[2:]),
+		RewardAddress: rewardAddr,
+		Signature:     hexutil.Encode(sig),
+	})
+	suite.Require().ErrorIs(err, types.ErrSignatureMismatch)
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       lowerAddr,
+		RewardAddress: rewardAddr,
+		Signature:     hexutil.Encode(sig),
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateAllocationsByCampaign() {
+	ctx := suite.ctx
+	cdc := suite.app.AppCodec()
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	claimedAddr := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	unclaimedAddr := "cosmos1unclaimed"
+
+	// allocations and claim records were keyed by address only
+	store.Set(append(types.KeyPrefixAirdropAllocation, []byte(claimedAddr)...), cdc.MustMarshal(&types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       claimedAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 500),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+		CampaignId:    2,
+	}))
+	store.Set(append(types.KeyPrefixAirdropAllocation, []byte(unclaimedAddr)...), cdc.MustMarshal(&types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       unclaimedAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 300),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	}))
+	store.Set(append(types.KeyPrefixClaimRecord, []byte(claimedAddr)...), cdc.MustMarshal(&types.ClaimRecord{
+		Address:         claimedAddr,
+		RewardAddress:   rewardAddr.String(),
+		ActionCompleted: make([]bool, len(types.Action_name)),
+		StakeFraction:   sdk.ZeroDec(),
+	}))
+	store.Set(append(types.GetClaimRecordByRewardAddressPrefix(rewardAddr), claimedAddr...), []byte{})
+
+	// the migration keys them by the campaign of the allocation
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	suite.Require().False(store.Has(append(types.KeyPrefixAirdropAllocation, []byte(claimedAddr)...)))
+	suite.Require().False(store.Has(append(types.KeyPrefixClaimRecord, []byte(claimedAddr)...)))
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, 0, claimedAddr))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 500), suite.app.AirdropKeeper.GetAllocation(ctx, 2, claimedAddr).Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 300), suite.app.AirdropKeeper.GetAllocation(ctx, 0, unclaimedAddr).Amount)
+	suite.Require().Len(suite.app.AirdropKeeper.GetAllAllocations(ctx), 2)
+
+	record := suite.app.AirdropKeeper.GetClaimRecord(ctx, 2, claimedAddr)
+	suite.Require().NotNil(record)
+	suite.Require().Equal(uint64(2), record.CampaignId)
+	records := suite.app.AirdropKeeper.GetClaimRecordsByRewardAddress(ctx, rewardAddr)
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(uint64(2), records[0].CampaignId)
+}
+
+func (suite *KeeperTestSuite) TestMigrateCollidingAddresses() {
+	ctx := suite.ctx
+	cdc := suite.app.AppCodec()
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	legacyRewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	merged := "0x7Fc66500c84A76Ad7e9c93437bFc5Ac33E2DDaE9"
+	deleted := "0x583e8DD54b7C3F5Ea23862E0E852f0e6914475D5"
+	setLegacyAllocation := func(address string, amount, claimed int64, campaignId uint64) {
+		store.Set(append(types.KeyPrefixAirdropAllocation, []byte(address)...), cdc.MustMarshal(&types.AirdropAllocation{
+			Chain:         "evm",
+			Address:       address,
+			Amount:        sdk.NewInt64Coin("ufury", amount),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", claimed),
+			CampaignId:    campaignId,
+		}))
+	}
+	setLegacyClaimRecord := func(address string, rewardAddr sdk.AccAddress) {
+		store.Set(append(types.KeyPrefixClaimRecord, []byte(address)...), cdc.MustMarshal(&types.ClaimRecord{
+			Address:         address,
+			RewardAddress:   rewardAddr.String(),
+			ActionCompleted: make([]bool, len(types.Action_name)),
+			StakeFraction:   sdk.ZeroDec(),
+		}))
+		store.Set(append(types.GetClaimRecordByRewardAddressPrefix(rewardAddr), address...), []byte{})
+	}
+
+	// entries stored under an address that is not normalized collide with the entries of the normalized address
+	setLegacyAllocation(merged, 500, 100, 0)
+	setLegacyAllocation(strings.ToLower(merged), 300, 50, 0)
+	setLegacyAllocation(deleted, 200, 0, 0)
+	setLegacyAllocation(strings.ToLower(deleted), 400, 0, 2)
+	setLegacyClaimRecord(merged, rewardAddr)
+	setLegacyClaimRecord(strings.ToLower(merged), legacyRewardAddr)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+
+	// colliding allocations of the same campaign are merged, the others are deleted and release their reserve
+	allocations := suite.app.AirdropKeeper.GetAllAllocations(ctx)
+	suite.Require().Len(allocations, 2)
+	allocation := suite.app.AirdropKeeper.GetAllocation(ctx, 0, merged)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 800), allocation.Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 150), allocation.ClaimedAmount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), suite.app.AirdropKeeper.GetAllocation(ctx, 0, deleted).Amount)
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, 2, deleted))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 650+200), suite.app.AirdropKeeper.GetReservedAmount(ctx, 0, "ufury"))
+	suite.Require().True(suite.app.AirdropKeeper.GetReservedAmount(ctx, 2, "ufury").IsZero())
+
+	// the claim record of the normalized address is kept
+	suite.Require().Len(suite.app.AirdropKeeper.GetAllClaimRecords(ctx), 1)
+	suite.Require().Equal(rewardAddr.String(), suite.app.AirdropKeeper.GetClaimRecord(ctx, 0, merged).RewardAddress)
+	suite.Require().Empty(suite.app.AirdropKeeper.GetClaimRecordsByRewardAddress(ctx, legacyRewardAddr))
+	suite.Require().Len(suite.app.AirdropKeeper.GetClaimRecordsByRewardAddress(ctx, rewardAddr), 1)
 }
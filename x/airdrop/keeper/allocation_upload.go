@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetAllocationUpload(ctx sdk.Context, campaignId uint64) *types.AllocationUpload {
+	upload := types.AllocationUpload{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAllocationUploadKey(campaignId))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &upload)
+	return &upload
+}
+
+func (k Keeper) SetAllocationUpload(ctx sdk.Context, upload types.AllocationUpload) {
+	bz := k.cdc.MustMarshal(&upload)
+	ctx.KVStore(k.storeKey).Set(types.GetAllocationUploadKey(upload.CampaignId), bz)
+}
+
+func (k Keeper) GetAllAllocationUploads(ctx sdk.Context) []types.AllocationUpload {
+	uploads := []types.AllocationUpload{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixAllocationUpload)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		upload := types.AllocationUpload{}
+		k.cdc.MustUnmarshal(iterator.Value(), &upload)
+
+		uploads = append(uploads, upload)
+	}
+	return uploads
+}
+
+// GetAvailableBalance returns the balance allocations of the campaign are paid from,
+// the unreserved module account balance for the module owner pool.
+func (k Keeper) GetAvailableBalance(ctx sdk.Context, campaignId uint64, denom string) (sdk.Coin, error) {
+	if campaignId == 0 {
+		return k.GetOwnerPoolBalance(ctx, denom), nil
+	}
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return sdk.Coin{}, types.ErrCampaignDoesNotExists
+	}
+	return campaign.Balance, nil
+}
+
+// UploadAllocations sets a batch of allocations of a bulk upload of the campaign.
+// Batches must follow the last uploaded batch, or start a new upload with an empty previous checksum,
+// the allocated amount of the upload must stay within its total and every allocation must be funded.
+func (k Keeper) UploadAllocations(ctx sdk.Context, msg types.MsgSetAllocations) (*types.AllocationUpload, error) {
+	if err := k.EnsureOwner(ctx, msg.CampaignId, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := k.EnsureCampaignDenom(ctx, msg.CampaignId, msg.Total.Denom); err != nil {
+		return nil, err
+	}
+
+	upload := &types.AllocationUpload{
+		CampaignId: msg.CampaignId,
+		Allocated:  sdk.NewCoin(msg.Total.Denom, sdk.ZeroInt()),
+		Total:      msg.Total,
+	}
+	if msg.PrevChecksum != "" {
+		upload = k.GetAllocationUpload(ctx, msg.CampaignId)
+		if upload == nil || upload.Checksum != msg.PrevChecksum {
+			return nil, sdkerrors.Wrap(types.ErrInvalidAllocationsChecksum, "batch does not follow the last uploaded batch")
+		}
+		if !upload.Total.IsEqual(msg.Total) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAllocationsTotal, "upload total is %s", upload.Total)
+		}
+	}
+
+	checksum, err := types.AllocationsChecksum(msg.PrevChecksum, msg.Allocations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidAllocationsChecksum, err.Error())
+	}
+	if checksum != msg.Checksum {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAllocationsChecksum, "expected %s, got %s", checksum, msg.Checksum)
+	}
+
+	for _, allocation := range msg.Allocations {
+		upload.Allocated = upload.Allocated.Add(allocation.Amount)
+	}
+	if upload.Total.IsLT(upload.Allocated) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAllocationsTotal, "allocated %s exceeds the total", upload.Allocated)
+	}
+	for _, allocation := range msg.Allocations {
+		if err := k.EnsureAllocationFunded(ctx, allocation); err != nil {
+			return nil, err
+		}
+		k.SetAllocation(ctx, allocation)
+	}
+	upload.Checksum = checksum
+	upload.Count += uint64(len(msg.Allocations))
+	k.SetAllocationUpload(ctx, *upload)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAllocations,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", msg.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", upload.Count)),
+			sdk.NewAttribute(types.AttributeKeyChecksum, upload.Checksum),
+			sdk.NewAttribute(types.AttributeKeyAmount, upload.Allocated.String()),
+		),
+	)
+	return upload, nil
+}
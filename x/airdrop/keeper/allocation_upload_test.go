@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestSetAllocations() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	partner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 400)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, partner, sdk.Coins{sdk.NewInt64Coin("ufury", 400)}))
+	res, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+	campaignId := res.Id
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partner, sdk.Coins{sdk.NewInt64Coin("ufury", 300)}, campaignId))
+	suite.Require().NoError(err)
+
+	allocations := []types.AirdropAllocation{}
+	for i := 0; i < 4; i++ {
+		allocations = append(allocations, types.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       fmt.Sprintf("cosmos1address%d", i),
+			Amount:        sdk.NewInt64Coin("ufury", 100),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+			CampaignId:    campaignId,
+		})
+	}
+	total := sdk.NewInt64Coin("ufury", 400)
+	upload := func(prevChecksum string, batch []types.AirdropAllocation, total sdk.Coin) (*types.MsgSetAllocationsResponse, error) {
+		checksum, err := types.AllocationsChecksum(prevChecksum, batch)
+		suite.Require().NoError(err)
+		msg := types.NewMsgSetAllocations(partner.String(), campaignId, batch, prevChecksum, checksum, total)
+		suite.Require().NoError(msg.ValidateBasic())
+		return msgServer.SetAllocations(wctx, msg)
+	}
+
+	// only the campaign owner uploads allocations
+	checksum, err := types.AllocationsChecksum("", allocations[:2])
+	suite.Require().NoError(err)
+	_, err = msgServer.SetAllocations(wctx, types.NewMsgSetAllocations(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), campaignId, allocations[:2], "", checksum, total))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+
+	first, err := upload("", allocations[:2], total)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), first.Count)
+	suite.Require().NotNil(suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, allocations[1].Address))
+
+	// batches must follow the last batch with the same total
+	_, err = upload("", allocations[2:3], total)
+	suite.Require().NoError(err)
+	_, err = upload(first.Checksum, allocations[2:3], total)
+	suite.Require().ErrorIs(err, types.ErrInvalidAllocationsChecksum)
+
+	first, err = upload("", allocations[:2], total)
+	suite.Require().NoError(err)
+	_, err = upload(first.Checksum, allocations[2:3], sdk.NewInt64Coin("ufury", 500))
+	suite.Require().ErrorIs(err, types.ErrInvalidAllocationsTotal)
+
+	// the upload cannot allocate more than the funded balance
+	cacheCtx, _ := ctx.CacheContext()
+	checksum, err = types.AllocationsChecksum(first.Checksum, allocations[2:])
+	suite.Require().NoError(err)
+	_, err = msgServer.SetAllocations(sdk.WrapSDKContext(cacheCtx), types.NewMsgSetAllocations(partner.String(), campaignId, allocations[2:], first.Checksum, checksum, total))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnallocatedBalance)
+
+	second, err := upload(first.Checksum, allocations[2:3], total)
+	suite.Require().NoError(err)
+	stored := suite.app.AirdropKeeper.GetAllocationUpload(ctx, campaignId)
+	suite.Require().Equal(uint64(3), stored.Count)
+	suite.Require().Equal(second.Checksum, stored.Checksum)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 300), stored.Allocated)
+	fullChecksum, err := types.AllocationsChecksum("", allocations[:3])
+	suite.Require().NoError(err)
+	suite.Require().Equal(fullChecksum, stored.Checksum)
+
+	// the upload cannot allocate more than its total
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partner, sdk.Coins{sdk.NewInt64Coin("ufury", 100)}, campaignId))
+	suite.Require().NoError(err)
+	extra := allocations[3]
+	extra.Amount = sdk.NewInt64Coin("ufury", 200)
+	_, err = upload(second.Checksum, []types.AirdropAllocation{extra}, total)
+	suite.Require().ErrorIs(err, types.ErrInvalidAllocationsTotal)
+
+	last, err := upload(second.Checksum, allocations[3:], total)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), last.Count)
+	suite.Require().Len(suite.app.AirdropKeeper.GetAllAllocationUploads(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestSetAllocationsAfterClaims() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	partner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 200)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, partner, sdk.Coins{sdk.NewInt64Coin("ufury", 200)}))
+	res, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+	campaignId := res.Id
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partner, sdk.Coins{sdk.NewInt64Coin("ufury", 200)}, campaignId))
+	suite.Require().NoError(err)
+
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+	allocations := []types.AirdropAllocation{{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 100),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+		CampaignId:    campaignId,
+	}, {
+		Chain:         "cosmos",
+		Address:       "cosmos1address",
+		Amount:        sdk.NewInt64Coin("ufury", 100),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+		CampaignId:    campaignId,
+	}}
+	total := sdk.NewInt64Coin("ufury", 200)
+	checksum, err := types.AllocationsChecksum("", allocations[:1])
+	suite.Require().NoError(err)
+	first, err := msgServer.SetAllocations(wctx, types.NewMsgSetAllocations(partner.String(), campaignId, allocations[:1], "", checksum, total))
+	suite.Require().NoError(err)
+
+	// a claim lowers the campaign balance below the allocated amount of the upload
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddr.String(),
+		Signature:     sign(rewardAddr.String(), campaignId),
+		CampaignId:    campaignId,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 175), suite.app.AirdropKeeper.GetCampaign(ctx, campaignId).Balance)
+
+	// the next batch is still funded
+	checksum, err = types.AllocationsChecksum(first.Checksum, allocations[1:])
+	suite.Require().NoError(err)
+	last, err := msgServer.SetAllocations(wctx, types.NewMsgSetAllocations(partner.String(), campaignId, allocations[1:], first.Checksum, checksum, total))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), last.Count)
+}
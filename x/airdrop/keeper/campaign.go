@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetLastCampaignId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastCampaignId)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastCampaignId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastCampaignId, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) *types.Campaign {
+	campaign := types.Campaign{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCampaignKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &campaign)
+	return &campaign
+}
+
+func (k Keeper) GetAllCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaign)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		campaign := types.Campaign{}
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+
+		campaigns = append(campaigns, campaign)
+	}
+	return campaigns
+}
+
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaign)
+	bz := k.cdc.MustMarshal(&campaign)
+	prefixStore.Set(sdk.Uint64ToBigEndian(campaign.Id), bz)
+}
+
+// CreateCampaign stores a new unfunded campaign and returns its id
+func (k Keeper) CreateCampaign(ctx sdk.Context, owner string, denom string, startTime time.Time, endTime time.Time, vesting types.VestingParams) uint64 {
+	id := k.GetLastCampaignId(ctx) + 1
+	k.SetLastCampaignId(ctx, id)
+	k.SetCampaign(ctx, types.Campaign{
+		Id:        id,
+		Owner:     owner,
+		Denom:     denom,
+		Balance:   sdk.NewCoin(denom, sdk.ZeroInt()),
+		StartTime: startTime,
+		EndTime:   endTime,
+		Vesting:   vesting,
+	})
+	return id
+}
+
+// IsCampaignActive returns true if the block time is within the claim window of the campaign
+func (k Keeper) IsCampaignActive(ctx sdk.Context, campaign types.Campaign) bool {
+	if ctx.BlockTime().Before(campaign.StartTime) {
+		return false
+	}
+	return campaign.EndTime.IsZero() || ctx.BlockTime().Before(campaign.EndTime)
+}
+
+// EnsureOwner returns an error if the sender does not own the campaign,
+// the module owner pool being owned by the params owner.
+func (k Keeper) EnsureOwner(ctx sdk.Context, campaignId uint64, sender string) error {
+	if campaignId == 0 {
+		if sender != k.GetParamSet(ctx).Owner {
+			return types.ErrNotEnoughPermission
+		}
+		return nil
+	}
+
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return types.ErrCampaignDoesNotExists
+	}
+	if sender != campaign.Owner {
+		return types.ErrNotEnoughPermission
+	}
+	return nil
+}
+
+// EnsureCampaignDenom returns an error if coins of the denom cannot be allocated by the campaign
+func (k Keeper) EnsureCampaignDenom(ctx sdk.Context, campaignId uint64, denom string) error {
+	if campaignId == 0 {
+		return nil
+	}
+
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return types.ErrCampaignDoesNotExists
+	}
+	if denom != campaign.Denom {
+		return types.ErrInvalidCampaignDenom
+	}
+	return nil
+}
+
+// GetOwnerPoolBalance returns the module account balance not reserved by campaigns
+func (k Keeper) GetOwnerPoolBalance(ctx sdk.Context, denom string) sdk.Coin {
+	moduleAddr := k.acountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+	for _, campaign := range k.GetAllCampaigns(ctx) {
+		if campaign.Denom == denom {
+			balance.Amount = balance.Amount.Sub(campaign.Balance.Amount)
+		}
+	}
+	if balance.IsNegative() {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	return balance
+}
+
+// FundCampaign increases the balance of the campaign by deposited coins
+func (k Keeper) FundCampaign(ctx sdk.Context, campaignId uint64, amount sdk.Coins) error {
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return types.ErrCampaignDoesNotExists
+	}
+
+	for _, coin := range amount {
+		if coin.Denom != campaign.Denom {
+			return types.ErrInvalidCampaignDenom
+		}
+		campaign.Balance = campaign.Balance.Add(coin)
+	}
+	k.SetCampaign(ctx, *campaign)
+	return nil
+}
+
+// SpendFromCampaign reserves coins paid out of the campaign, checking its claim window and balance.
+// Payments of the module owner pool are bounded by the balance not reserved by campaigns.
+func (k Keeper) SpendFromCampaign(ctx sdk.Context, campaignId uint64, coin sdk.Coin) error {
+	if campaignId == 0 {
+		if k.GetOwnerPoolBalance(ctx, coin.Denom).IsLT(coin) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "owner pool balance is lower than %s", coin)
+		}
+		return nil
+	}
+
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return types.ErrCampaignDoesNotExists
+	}
+	if !k.IsCampaignActive(ctx, *campaign) {
+		return types.ErrCampaignNotActive
+	}
+	if campaign.Denom != coin.Denom {
+		return types.ErrInvalidCampaignDenom
+	}
+	if campaign.Balance.IsLT(coin) {
+		return types.ErrInsufficientCampaignBalance
+	}
+
+	campaign.Balance = campaign.Balance.Sub(coin)
+	k.SetCampaign(ctx, *campaign)
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestCampaignFundsIsolation() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	partnerA := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	partnerB := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 4000)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, partnerA, sdk.Coins{sdk.NewInt64Coin("ufury", 4000)}))
+
+	resA, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partnerA, "ufury", time.Unix(0, 0), time.Unix(2000, 0), types.VestingParams{}))
+	suite.Require().NoError(err)
+	resB, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partnerB, "ufury", time.Unix(0, 0), time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partnerA, sdk.Coins{sdk.NewInt64Coin("ufury", 4000)}, resA.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(4000), suite.app.AirdropKeeper.GetCampaign(ctx, resA.Id).Balance.Amount.Int64())
+
+	// only the campaign owner allocates from the campaign
+	type claimer struct {
+		addr   sdk.AccAddress
+		pubKey string
+		sign   func(string, uint64) string
+	}
+	newAllocation := func(campaignId uint64) (types.AirdropAllocation, claimer) {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+		return types.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       cosmosAddr,
+			Amount:        sdk.NewInt64Coin("ufury", 4000),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+			CampaignId:    campaignId,
+		}, claimer{addr, pubKey, sign}
+	}
+	claim := func(ctx sdk.Context, allocation types.AirdropAllocation, c claimer) error {
+		return suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+			Address:       allocation.Address,
+			PubKey:        c.pubKey,
+			RewardAddress: c.addr.String(),
+			Signature:     c.sign(c.addr.String(), allocation.CampaignId),
+			CampaignId:    allocation.CampaignId,
+		})
+	}
+	allocationA, claimerA := newAllocation(resA.Id)
+	_, err = msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(partnerB.String(), allocationA))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	_, err = msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(partnerA.String(), allocationA))
+	suite.Require().NoError(err)
+
+	// allocations of campaign B are not funded by campaign A funds
+	allocationB, claimerB := newAllocation(resB.Id)
+	_, err = msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(partnerB.String(), allocationB))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnallocatedBalance)
+	suite.app.AirdropKeeper.SetAllocation(ctx, allocationB)
+
+	// campaign B and the owner pool cannot spend campaign A funds
+	err = claim(ctx, allocationB, claimerB)
+	suite.Require().ErrorIs(err, types.ErrInsufficientCampaignBalance)
+	suite.Require().True(suite.app.AirdropKeeper.GetOwnerPoolBalance(ctx, "ufury").IsZero())
+
+	// campaign A pays from its own balance within its claim window
+	err = claim(ctx.WithBlockTime(time.Unix(2000, 0)), allocationA, claimerA)
+	suite.Require().ErrorIs(err, types.ErrCampaignNotActive)
+	err = claim(ctx, allocationA, claimerA)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(3000), suite.app.AirdropKeeper.GetCampaign(ctx, resA.Id).Balance.Amount.Int64())
+	suite.Require().Equal(int64(1000), suite.app.BankKeeper.GetBalance(ctx, claimerA.addr, "ufury").Amount.Int64())
+
+	// owner pool claims are bounded by the unreserved balance
+	allocation, ownerPoolClaimer := newAllocation(0)
+	suite.app.AirdropKeeper.SetAllocation(ctx, allocation)
+	err = claim(ctx, allocation, ownerPoolClaimer)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetLastClaimReceiptId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastClaimReceiptId)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastClaimReceiptId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastClaimReceiptId, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetClaimReceipt(ctx sdk.Context, id uint64) *types.ClaimReceipt {
+	receipt := types.ClaimReceipt{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimReceiptKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &receipt)
+	return &receipt
+}
+
+func (k Keeper) GetAllClaimReceipts(ctx sdk.Context) []types.ClaimReceipt {
+	receipts := []types.ClaimReceipt{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClaimReceipt)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := types.ClaimReceipt{}
+		k.cdc.MustUnmarshal(iterator.Value(), &receipt)
+
+		receipts = append(receipts, receipt)
+	}
+	return receipts
+}
+
+// GetClaimReceiptsByRewardAddress returns the receipts of the allocations claimed by a furya address
+func (k Keeper) GetClaimReceiptsByRewardAddress(ctx sdk.Context, rewardAddr sdk.AccAddress) []types.ClaimReceipt {
+	receipts := []types.ClaimReceipt{}
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetClaimReceiptByRewardAddressPrefix(rewardAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := k.GetClaimReceipt(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefixKey):]))
+		if receipt != nil {
+			receipts = append(receipts, *receipt)
+		}
+	}
+	return receipts
+}
+
+func (k Keeper) SetClaimReceipt(ctx sdk.Context, receipt types.ClaimReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&receipt)
+	store.Set(types.GetClaimReceiptKey(receipt.Id), bz)
+
+	rewardAddr, err := sdk.AccAddressFromBech32(receipt.RewardAddress)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetClaimReceiptByRewardAddressKey(rewardAddr, receipt.Id), []byte{})
+}
+
+// RecordClaimReceipt stores the receipt of a payout of the allocation claimed by the reward address
+func (k Keeper) RecordClaimReceipt(ctx sdk.Context, allocation types.AirdropAllocation, rewardAddr string, recipient string, amount sdk.Coin) types.ClaimReceipt {
+	txHash := ""
+	if len(ctx.TxBytes()) > 0 {
+		txHash = fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	}
+
+	id := k.GetLastClaimReceiptId(ctx) + 1
+	k.SetLastClaimReceiptId(ctx, id)
+	receipt := types.ClaimReceipt{
+		Id:            id,
+		Chain:         allocation.Chain,
+		Address:       allocation.Address,
+		CampaignId:    allocation.CampaignId,
+		RewardAddress: rewardAddr,
+		Recipient:     recipient,
+		Amount:        amount,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+		TxHash:        txHash,
+	}
+	k.SetClaimReceipt(ctx, receipt)
+	return receipt
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimReceipts() {
+	blockTime := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	txBytes := []byte("claim tx")
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime).WithTxBytes(txBytes)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	suite.app.AirdropKeeper.SetAllocation(ctx, types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+
+	// every destination of a claim gets a receipt indexed by the reward address
+	err := suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddr.String(),
+		Signature:     sign(rewardAddr.String(), 0),
+		Destinations: []types.ClaimDestination{
+			{Address: rewardAddr.String(), Amount: sdk.NewInt64Coin("ufury", 150)},
+			{Address: otherAddr.String(), Amount: sdk.NewInt64Coin("ufury", 100)},
+		},
+	})
+	suite.Require().NoError(err)
+
+	receipts := suite.app.AirdropKeeper.GetClaimReceiptsByRewardAddress(ctx, rewardAddr)
+	suite.Require().Equal([]types.ClaimReceipt{
+		{
+			Id:            1,
+			Chain:         "cosmos",
+			Address:       cosmosAddr,
+			RewardAddress: rewardAddr.String(),
+			Recipient:     rewardAddr.String(),
+			Amount:        sdk.NewInt64Coin("ufury", 150),
+			Height:        10,
+			Time:          blockTime,
+			TxHash:        fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		},
+		{
+			Id:            2,
+			Chain:         "cosmos",
+			Address:       cosmosAddr,
+			RewardAddress: rewardAddr.String(),
+			Recipient:     otherAddr.String(),
+			Amount:        sdk.NewInt64Coin("ufury", 100),
+			Height:        10,
+			Time:          blockTime,
+			TxHash:        fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		},
+	}, receipts)
+	suite.Require().Empty(suite.app.AirdropKeeper.GetClaimReceiptsByRewardAddress(ctx, otherAddr))
+
+	// tranches paid out by actions outside of transactions are recorded without a tx hash
+	suite.app.AirdropKeeper.AfterAction(ctx.WithTxBytes(nil), rewardAddr, types.ActionVote)
+	receipt := suite.app.AirdropKeeper.GetClaimReceipt(ctx, 3)
+	suite.Require().NotNil(receipt)
+	suite.Require().Equal(rewardAddr.String(), receipt.Recipient)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 250), receipt.Amount)
+	suite.Require().Empty(receipt.TxHash)
+
+	res, err := suite.app.AirdropKeeper.ClaimReceipts(wctx, &types.QueryClaimReceiptsRequest{
+		RewardAddress: rewardAddr.String(),
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Receipts, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Equal(receipts, res.Receipts)
+
+	res, err = suite.app.AirdropKeeper.ClaimReceipts(wctx, &types.QueryClaimReceiptsRequest{
+		RewardAddress: rewardAddr.String(),
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ClaimReceipt{*receipt}, res.Receipts)
+
+	_, err = suite.app.AirdropKeeper.ClaimReceipts(wctx, &types.QueryClaimReceiptsRequest{RewardAddress: "invalid"})
+	suite.Require().Error(err)
+}
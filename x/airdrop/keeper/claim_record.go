@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetClaimRecord(ctx sdk.Context, campaignId uint64, address string) *types.ClaimRecord {
+	record := types.ClaimRecord{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimRecordKey(campaignId, types.NormalizeAddress(address)))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+func (k Keeper) GetAllClaimRecords(ctx sdk.Context) []types.ClaimRecord {
+	records := []types.ClaimRecord{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixClaimRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ClaimRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetClaimRecordsByRewardAddress returns the claim records paying out to a furya address
+func (k Keeper) GetClaimRecordsByRewardAddress(ctx sdk.Context, rewardAddr sdk.AccAddress) []types.ClaimRecord {
+	records := []types.ClaimRecord{}
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetClaimRecordByRewardAddressPrefix(rewardAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(prefixKey):]
+		record := k.GetClaimRecord(ctx, sdk.BigEndianToUint64(key[:8]), string(key[8:]))
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+	return records
+}
+
+func (k Keeper) SetClaimRecord(ctx sdk.Context, record types.ClaimRecord) {
+	record.Address = types.NormalizeAddress(record.Address)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetClaimRecordKey(record.CampaignId, record.Address), bz)
+
+	rewardAddr, err := sdk.AccAddressFromBech32(record.RewardAddress)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetClaimRecordByRewardAddressKey(rewardAddr, record.CampaignId, record.Address), []byte{})
+}
+
+func (k Keeper) DeleteClaimRecord(ctx sdk.Context, campaignId uint64, address string) {
+	record := k.GetClaimRecord(ctx, campaignId, address)
+	if record == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetClaimRecordKey(record.CampaignId, record.Address))
+
+	rewardAddr, err := sdk.AccAddressFromBech32(record.RewardAddress)
+	if err != nil {
+		panic(err)
+	}
+	store.Delete(types.GetClaimRecordByRewardAddressKey(rewardAddr, record.CampaignId, record.Address))
+}
+
+// UnlockedAmount returns the part of the allocation unlocked by the actions completed on the claim record.
+// Each action unlocks an equal tranche and completing every action unlocks the whole allocation.
+func (k Keeper) UnlockedAmount(allocation types.AirdropAllocation, record types.ClaimRecord) sdk.Coin {
+	numCompleted := 0
+	for _, completed := range record.ActionCompleted {
+		if completed {
+			numCompleted++
+		}
+	}
+	if numCompleted == len(record.ActionCompleted) {
+		return allocation.Amount
+	}
+
+	tranche := allocation.Amount.Amount.QuoRaw(int64(len(record.ActionCompleted)))
+	return sdk.NewCoin(allocation.Amount.Denom, tranche.MulRaw(int64(numCompleted)))
+}
+
+// ClaimableAmount returns the unlocked part of the allocation that is not claimed yet.
+func (k Keeper) ClaimableAmount(allocation types.AirdropAllocation, record types.ClaimRecord) sdk.Coin {
+	unlocked := k.UnlockedAmount(allocation, record)
+	if unlocked.IsLT(allocation.ClaimedAmount) {
+		return sdk.NewCoin(allocation.Amount.Denom, sdk.ZeroInt())
+	}
+	return unlocked.Sub(allocation.ClaimedAmount)
+}
+
+// ClaimableForAction returns the tranche unlocked by completing an action on an allocation.
+// The tranche that completes the last action unlocks whatever remains.
+func (k Keeper) ClaimableForAction(allocation types.AirdropAllocation, record types.ClaimRecord, action types.Action) sdk.Coin {
+	if record.ActionCompleted[action] {
+		return sdk.NewCoin(allocation.Amount.Denom, sdk.ZeroInt())
+	}
+
+	completed := types.ClaimRecord{ActionCompleted: make([]bool, len(record.ActionCompleted))}
+	copy(completed.ActionCompleted, record.ActionCompleted)
+	completed.ActionCompleted[action] = true
+
+	tranche := k.UnlockedAmount(allocation, completed).Sub(k.UnlockedAmount(allocation, record))
+	unclaimed := allocation.Amount.Sub(allocation.ClaimedAmount)
+	if unclaimed.IsLT(tranche) {
+		return unclaimed
+	}
+	return tranche
+}
+
+// CompleteAction marks the action as completed on the claim record and sends the unlocked tranche
+// to the reward address.
+func (k Keeper) CompleteAction(ctx sdk.Context, record types.ClaimRecord, action types.Action) error {
+	if record.ActionCompleted[action] {
+		return nil
+	}
+
+	allocation := k.GetAllocation(ctx, record.CampaignId, record.Address)
+	if allocation == nil {
+		return types.ErrAirdropAllocationDoesNotExists
+	}
+
+	rewardAddr, err := sdk.AccAddressFromBech32(record.RewardAddress)
+	if err != nil {
+		return err
+	}
+
+	claimable := k.ClaimableForAction(*allocation, record, action)
+	if claimable.IsPositive() {
+		err = k.SpendFromCampaign(ctx, allocation.CampaignId, claimable)
+		if err != nil {
+			return err
+		}
+
+		err = k.SendClaimedCoins(ctx, allocation.CampaignId, rewardAddr, sdk.Coins{claimable})
+		if err != nil {
+			return err
+		}
+		k.RecordClaimReceipt(ctx, *allocation, record.RewardAddress, record.RewardAddress, claimable)
+	}
+
+	allocation.ClaimedAmount = allocation.ClaimedAmount.Add(claimable)
+	k.SetAllocation(ctx, *allocation)
+
+	record.ActionCompleted[action] = true
+	k.SetClaimRecord(ctx, record)
+
+	// stake the tranche following the claim stake preference, it stays liquid if the delegation fails
+	if claimable.IsPositive() && record.ValidatorAddress != "" && !record.StakeFraction.IsNil() && record.StakeFraction.IsPositive() {
+		cacheCtx, write := ctx.CacheContext()
+		err = k.DelegateClaim(cacheCtx, rewardAddr, claimable, record.ValidatorAddress, record.StakeFraction)
+		if err != nil {
+			k.Logger(ctx).Error("failed to stake airdrop tranche", "address", record.Address, "validator", record.ValidatorAddress, "error", err)
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteAction,
+			sdk.NewAttribute(types.AttributeKeyAddress, record.Address),
+			sdk.NewAttribute(types.AttributeKeyRewardAddress, record.RewardAddress),
+			sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimable.String()),
+		),
+	)
+
+	return nil
+}
+
+// AfterAction completes the action on every claim record paying out to the address.
+// Failures are logged and leave the action incomplete so it can be retried later.
+func (k Keeper) AfterAction(ctx sdk.Context, rewardAddr sdk.AccAddress, action types.Action) {
+	for _, record := range k.GetClaimRecordsByRewardAddress(ctx, rewardAddr) {
+		if record.ActionCompleted[action] {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.CompleteAction(cacheCtx, record, action); err != nil {
+			k.Logger(ctx).Error("failed to complete airdrop action", "address", record.Address, "action", action.String(), "error", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimAllocationByActions() {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000003)})
+	suite.app.AirdropKeeper.SetAllocation(suite.ctx, types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 1000003),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+
+	// claim pays out the initial claim tranche only
+	claimMsg := types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: addr.String(),
+		Signature:     sign(addr.String(), 0),
+	}
+	err := suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, claimMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(250000), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount.Int64())
+
+	record := suite.app.AirdropKeeper.GetClaimRecord(suite.ctx, 0, cosmosAddr)
+	suite.Require().NotNil(record)
+	suite.Require().Equal([]bool{true, false, false, false}, record.ActionCompleted)
+	suite.Require().Len(suite.app.AirdropKeeper.GetClaimRecordsByRewardAddress(suite.ctx, addr), 1)
+
+	// second claim is rejected
+	err = suite.app.AirdropKeeper.ClaimAllocation(suite.ctx, claimMsg)
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationAlreadyClaimed)
+
+	// completing an action twice pays once
+	hooks := suite.app.AirdropKeeper.Hooks()
+	hooks.AfterProposalVote(suite.ctx, 1, addr)
+	hooks.AfterProposalVote(suite.ctx, 2, addr)
+	suite.Require().Equal(int64(500000), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount.Int64())
+
+	// actions from unrelated addresses do nothing
+	hooks.AfterDelegationModified(suite.ctx, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.ValAddress{})
+	suite.Require().Equal(int64(500000), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount.Int64())
+
+	// last action pays out the remainder
+	hooks.AfterDelegationModified(suite.ctx, addr, sdk.ValAddress{})
+	suite.app.AirdropKeeper.AfterAction(suite.ctx, addr, types.ActionIBCTransfer)
+	suite.Require().Equal(int64(1000003), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount.Int64())
+
+	allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, cosmosAddr)
+	suite.Require().Equal(allocation.Amount, allocation.ClaimedAmount)
+}
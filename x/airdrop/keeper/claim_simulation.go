@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// SimulateClaimAllocation runs the claim on a cache of the state, which is discarded, and returns whether it
+// would be accepted, the amount it would pay out or why it would be rejected.
+func (k Keeper) SimulateClaimAllocation(ctx sdk.Context, msg types.MsgClaimAllocation) types.QuerySimulateClaimResponse {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	res := types.QuerySimulateClaimResponse{
+		Amount: sdk.Coin{Amount: sdk.ZeroInt()},
+	}
+
+	err := k.simulateClaim(cacheCtx, msg, &res)
+	if err != nil {
+		res.Codespace, res.Code, res.Error = sdkerrors.ABCIInfo(err, false)
+		return res
+	}
+	res.Success = true
+	return res
+}
+
+func (k Keeper) simulateClaim(ctx sdk.Context, msg types.MsgClaimAllocation, res *types.QuerySimulateClaimResponse) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.MerkleClaim != nil {
+		if err := k.ClaimMerkleLeaf(ctx, msg.CampaignId, msg.Address, *msg.MerkleClaim); err != nil {
+			return err
+		}
+	}
+
+	allocation := k.GetAllocation(ctx, msg.CampaignId, msg.Address)
+	if allocation == nil {
+		return types.ErrAirdropAllocationDoesNotExists
+	}
+	res.Amount = sdk.NewCoin(allocation.Amount.Denom, sdk.ZeroInt())
+
+	// only the first claim is signed, later claims are accepted from the recorded reward address
+	if record := k.GetClaimRecord(ctx, msg.CampaignId, msg.Address); record != nil {
+		res.SignatureVerified = record.RewardAddress == msg.RewardAddress
+	} else {
+		res.SignatureVerified = k.VerifyClaimSignature(ctx, *allocation, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature) == nil
+	}
+
+	if err := k.ClaimAllocation(ctx, msg); err != nil {
+		return err
+	}
+	claimed := k.GetAllocation(ctx, msg.CampaignId, msg.Address).ClaimedAmount
+	res.Amount = claimed.Sub(allocation.ClaimedAmount)
+	return nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestSimulateClaim() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	k := suite.app.AirdropKeeper
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	k.SetAllocation(ctx, types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+	claim := types.MsgClaimAllocation{
+		Address:       cosmosAddr,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddr.String(),
+		Signature:     sign(rewardAddr.String(), 0),
+	}
+
+	_, err := k.SimulateClaim(wctx, nil)
+	suite.Require().Error(err)
+
+	// a valid claim reports the amount it would pay out without paying it
+	res, err := k.SimulateClaim(wctx, &types.QuerySimulateClaimRequest{Claim: claim})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Success)
+	suite.Require().True(res.SignatureVerified)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 250), res.Amount)
+	suite.Require().Empty(res.Error)
+	suite.Require().Nil(k.GetClaimRecord(ctx, 0, cosmosAddr))
+	suite.Require().True(k.GetAllocation(ctx, 0, cosmosAddr).ClaimedAmount.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, rewardAddr).IsZero())
+
+	// a rejected claim reports why
+	invalid := claim
+	invalid.Signature = sign(rewardAddr.String(), 1)
+	res, err = k.SimulateClaim(wctx, &types.QuerySimulateClaimRequest{Claim: invalid})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Success)
+	suite.Require().False(res.SignatureVerified)
+	suite.Require().Equal(types.ModuleName, res.Codespace)
+	suite.Require().Equal(types.ErrSignatureMismatch.ABCICode(), res.Code)
+	suite.Require().True(res.Amount.IsZero())
+
+	invalid = claim
+	invalid.Address = "cosmos1unknown"
+	res, err = k.SimulateClaim(wctx, &types.QuerySimulateClaimRequest{Claim: invalid})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ErrAirdropAllocationDoesNotExists.ABCICode(), res.Code)
+
+	// later claims of the reward address need no signature
+	suite.Require().NoError(k.ClaimAllocation(ctx, claim))
+	later := claim
+	later.Signature = ""
+	res, err = k.SimulateClaim(wctx, &types.QuerySimulateClaimRequest{Claim: later})
+	suite.Require().NoError(err)
+	suite.Require().True(res.SignatureVerified)
+	suite.Require().False(res.Success)
+	suite.Require().Equal(types.ErrAirdropAllocationAlreadyClaimed.ABCICode(), res.Code)
+}
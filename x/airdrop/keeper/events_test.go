@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// typedEvents returns the typed events of the context event manager
+func (suite *KeeperTestSuite) typedEvents(ctx sdk.Context) []proto.Message {
+	msgs := []proto.Message{}
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func (suite *KeeperTestSuite) TestClaimErrorsAndTypedEvents() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+	previousOwner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+	_, otherPubKey, _ := suite.cosmosClaimer()
+
+	params := suite.app.AirdropKeeper.GetParamSet(ctx)
+	params.Owner = previousOwner.String()
+	suite.app.AirdropKeeper.SetParamSet(ctx, params)
+	_, err := msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(previousOwner, owner.String()))
+	suite.Require().NoError(err)
+	_, err = msgServer.AcceptModuleOwnership(wctx, types.NewMsgAcceptModuleOwnership(owner))
+	suite.Require().NoError(err)
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	suite.Require().NoError(err)
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(owner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, 0))
+	suite.Require().NoError(err)
+
+	allocation := types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	}
+	_, err = msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(owner.String(), allocation))
+	suite.Require().NoError(err)
+
+	// claims are rejected with an error explaining why the signature is invalid
+	claim := func(pubKey, signature string) error {
+		return suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+			Address:       cosmosAddr,
+			PubKey:        pubKey,
+			RewardAddress: rewardAddr,
+			Signature:     signature,
+		})
+	}
+	err = claim("0x1234", sign(rewardAddr, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidPubKey)
+	_, code, _ := sdkerrors.ABCIInfo(err, false)
+	suite.Require().Equal(types.ErrInvalidPubKey.ABCICode(), code)
+	suite.Require().ErrorIs(claim(otherPubKey, sign(rewardAddr, 0)), types.ErrPubKeyAddressMismatch)
+	suite.Require().ErrorIs(claim(pubKey, "0xzz"), types.ErrInvalidSignatureFormat)
+	suite.Require().ErrorIs(claim(pubKey, sign(rewardAddr, 1)), types.ErrSignatureMismatch)
+	// the initial claim pays out the initial claim tranche
+	suite.Require().NoError(claim(pubKey, sign(rewardAddr, 0)))
+
+	suite.Require().Equal([]proto.Message{
+		&types.EventOwnershipTransferred{
+			PreviousOwner: previousOwner.String(),
+			NewOwner:      owner.String(),
+		},
+		&types.EventDeposit{
+			Sender: owner.String(),
+			Amount: []sdk.Coin{sdk.NewInt64Coin("ufury", 1000)},
+		},
+		&types.EventSetAllocation{
+			Sender:  owner.String(),
+			Chain:   "cosmos",
+			Address: cosmosAddr,
+			Amount:  sdk.NewInt64Coin("ufury", 1000),
+		},
+		&types.EventClaim{
+			Chain:         "cosmos",
+			Address:       cosmosAddr,
+			RewardAddress: rewardAddr,
+			Amount:        sdk.NewInt64Coin("ufury", 250),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 250),
+		},
+	}, suite.typedEvents(ctx))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// GetFeelessClaimCount returns the number of fee-less claims accepted in the current block.
+func (k Keeper) GetFeelessClaimCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyFeelessClaimCount)
+	if len(bz) != 16 || int64(sdk.BigEndianToUint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz[8:])
+}
+
+// IncrementFeelessClaimCount counts a fee-less claim accepted in the current block.
+func (k Keeper) IncrementFeelessClaimCount(ctx sdk.Context) {
+	count := k.GetFeelessClaimCount(ctx) + 1
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), sdk.Uint64ToBigEndian(count)...)
+	ctx.KVStore(k.storeKey).Set(types.KeyFeelessClaimCount, bz)
+}
+
+// FeelessClaimCapReached returns true when the block accepted the max number of fee-less claims.
+func (k Keeper) FeelessClaimCapReached(ctx sdk.Context) bool {
+	maxClaims := k.GetParamSet(ctx).MaxFeelessClaimsPerBlock
+	return maxClaims != 0 && k.GetFeelessClaimCount(ctx) >= maxClaims
+}
+
+// TrialClaimAllocation runs the claim with a gas meter bounded by the fee-less claim gas limit,
+// ctx is expected to be a cache context discarded by the caller.
+func (k Keeper) TrialClaimAllocation(ctx sdk.Context, msg *types.MsgClaimAllocation) (err error) {
+	if gasLimit := k.GetParamSet(ctx).FeelessClaimGasLimit; gasLimit != 0 {
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "fee-less claim out of gas in location: %v", outOfGas.Descriptor)
+		}
+	}()
+
+	_, err = NewMsgServerImpl(k).ClaimAllocation(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/furysport/fury-chain/x/airdrop/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,7 +21,94 @@ func (k Keeper) Allocation(c context.Context, req *types.QueryAllocationRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 
 	return &types.QueryAllocationResponse{
-		Allocation: k.GetAllocation(ctx, req.Address),
+		Allocation: k.GetAllocation(ctx, req.CampaignId, req.Address),
+	}, nil
+}
+
+func (k Keeper) ClaimRecord(c context.Context, req *types.QueryClaimRecordRequest) (*types.QueryClaimRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryClaimRecordResponse{
+		ClaimRecord: k.GetClaimRecord(ctx, req.CampaignId, req.Address),
+	}, nil
+}
+
+func (k Keeper) MerkleAirdrop(c context.Context, req *types.QueryMerkleAirdropRequest) (*types.QueryMerkleAirdropResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMerkleAirdropResponse{
+		MerkleAirdrop: k.GetMerkleAirdrop(ctx, req.Id),
+	}, nil
+}
+
+func (k Keeper) MerkleLeafClaimed(c context.Context, req *types.QueryMerkleLeafClaimedRequest) (*types.QueryMerkleLeafClaimedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMerkleLeafClaimedResponse{
+		Claimed: k.IsMerkleLeafClaimed(ctx, req.Id, req.Index),
+	}, nil
+}
+
+func (k Keeper) Campaign(c context.Context, req *types.QueryCampaignRequest) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCampaignResponse{
+		Campaign: k.GetCampaign(ctx, req.Id),
+	}, nil
+}
+
+func (k Keeper) AllocationUpload(c context.Context, req *types.QueryAllocationUploadRequest) (*types.QueryAllocationUploadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllocationUploadResponse{
+		AllocationUpload: k.GetAllocationUpload(ctx, req.CampaignId),
+	}, nil
+}
+
+func (k Keeper) Campaigns(c context.Context, req *types.QueryCampaignsRequest) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaigns := []types.Campaign{}
+	campaignStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaign)
+	pageRes, err := query.Paginate(campaignStore, req.Pagination, func(key []byte, value []byte) error {
+		campaign := types.Campaign{}
+		if err := k.cdc.Unmarshal(value, &campaign); err != nil {
+			return err
+		}
+		campaigns = append(campaigns, campaign)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignsResponse{
+		Campaigns:  campaigns,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -34,3 +123,82 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 		Params: k.GetParamSet(ctx),
 	}, nil
 }
+
+func (k Keeper) Ownership(c context.Context, req *types.QueryOwnershipRequest) (*types.QueryOwnershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryOwnershipResponse{
+		Owner:        k.GetParamSet(ctx).Owner,
+		PendingOwner: k.GetPendingOwner(ctx),
+	}, nil
+}
+
+func (k Keeper) Snapshot(c context.Context, req *types.QuerySnapshotRequest) (*types.QuerySnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySnapshotResponse{
+		Snapshot: k.GetSnapshot(ctx, req.CampaignId),
+	}, nil
+}
+
+func (k Keeper) Solvency(c context.Context, req *types.QuerySolvencyRequest) (*types.QuerySolvencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySolvencyResponse{
+		Solvency: k.GetSolvency(ctx),
+	}, nil
+}
+
+func (k Keeper) ClaimReceipts(c context.Context, req *types.QueryClaimReceiptsRequest) (*types.QueryClaimReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rewardAddr, err := sdk.AccAddressFromBech32(req.RewardAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipts := []types.ClaimReceipt{}
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetClaimReceiptByRewardAddressPrefix(rewardAddr))
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		receipt := k.GetClaimReceipt(ctx, sdk.BigEndianToUint64(key))
+		if receipt != nil {
+			receipts = append(receipts, *receipt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClaimReceiptsResponse{
+		Receipts:   receipts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) SimulateClaim(c context.Context, req *types.QuerySimulateClaimRequest) (*types.QuerySimulateClaimResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := k.SimulateClaimAllocation(ctx, req.Claim)
+	return &res, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// autoDelegationKey marks the context of the delegations made by the module on behalf of a claimer
+type autoDelegationKey struct{}
+
+// Hooks wrapper struct for airdrop keeper
+type Hooks struct {
+	k Keeper
+}
+
+var (
+	_ stakingtypes.StakingHooks = Hooks{}
+	_ govtypes.GovHooks         = Hooks{}
+)
+
+// Hooks returns the wrapper struct for staking and gov hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// staking hooks
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if delegation, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); found {
+		bz, err := delegation.Shares.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		ctx.KVStore(h.k.storeKey).Set(types.GetDelegationSharesKey(delAddr, valAddr), bz)
+	}
+}
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	ctx.KVStore(h.k.storeKey).Delete(types.GetDelegationSharesKey(delAddr, valAddr))
+}
+
+// AfterDelegationModified completes the delegate action of a delegation made by the delegator, the
+// delegations made by the module while claiming and the modifications lowering the delegation are ignored
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(h.k.storeKey)
+	key := types.GetDelegationSharesKey(delAddr, valAddr)
+	bz := store.Get(key)
+	store.Delete(key)
+
+	if ctx.Value(autoDelegationKey{}) != nil {
+		return
+	}
+	if bz != nil {
+		var prevShares sdk.Dec
+		if err := prevShares.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		delegation, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		if !found || !delegation.Shares.GT(prevShares) {
+			return
+		}
+	}
+	h.k.AfterAction(ctx, delAddr, types.ActionDelegate)
+}
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {}
+
+// gov hooks
+func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+}
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	h.k.AfterAction(ctx, voterAddr, types.ActionVote)
+}
+func (h Hooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)  {}
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+var _ porttypes.ICS4Wrapper = ICS4Wrapper{}
+
+// ICS4Wrapper wraps the channel keeper used by the transfer module so that
+// outgoing fungible token transfers complete the IBC transfer airdrop action.
+type ICS4Wrapper struct {
+	k           Keeper
+	ics4Wrapper porttypes.ICS4Wrapper
+}
+
+// NewICS4Wrapper returns an ICS4Wrapper sending packets through the given wrapper
+func (k Keeper) NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper) ICS4Wrapper {
+	return ICS4Wrapper{
+		k:           k,
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// SendPacket sends the packet and completes the IBC transfer action for the sender
+func (w ICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := w.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	if packet.GetSourcePort() != transfertypes.PortID {
+		return nil
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil
+	}
+
+	w.k.AfterAction(ctx, sender, types.ActionIBCTransfer)
+	return nil
+}
+
+// WriteAcknowledgement writes the acknowledgement through the wrapped ICS4Wrapper
+func (w ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// RegisterInvariants registers all airdrop invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-solvency",
+		ModuleAccountSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claimed-amounts",
+		ClaimedAmountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "allocation-denoms",
+		AllocationDenomsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the airdrop module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountSolvencyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ClaimedAmountsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return AllocationDenomsInvariant(k)(ctx)
+	}
+}
+
+// GetSolvency returns, for every denom held by the module account or left to claim, the module
+// account balance and the unclaimed amount of the allocations and merkle airdrops.
+func (k Keeper) GetSolvency(ctx sdk.Context) []types.Solvency {
+	unclaimed := map[string]sdk.Int{}
+	add := func(denom string, amount sdk.Int) {
+		if current, ok := unclaimed[denom]; ok {
+			amount = amount.Add(current)
+		}
+		unclaimed[denom] = amount
+	}
+	for _, allocation := range k.GetAllAllocations(ctx) {
+		add(allocation.Amount.Denom, unclaimedAmount(allocation))
+	}
+	for _, airdrop := range k.GetAllMerkleAirdrops(ctx) {
+		add(airdrop.TotalAmount.Denom, unclaimedMerkleAmount(airdrop))
+	}
+
+	moduleAddr := k.acountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	for _, coin := range balances {
+		add(coin.Denom, sdk.ZeroInt())
+	}
+
+	denoms := make([]string, 0, len(unclaimed))
+	for denom := range unclaimed {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	solvency := make([]types.Solvency, 0, len(denoms))
+	for _, denom := range denoms {
+		balance := balances.AmountOf(denom)
+		solvency = append(solvency, types.Solvency{
+			Denom:     denom,
+			Balance:   balance,
+			Unclaimed: unclaimed[denom],
+			Margin:    balance.Sub(unclaimed[denom]),
+		})
+	}
+	return solvency
+}
+
+// ModuleAccountSolvencyInvariant checks that the module account balance covers the amount
+// left to claim by allocations and merkle airdrops
+func ModuleAccountSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, solvency := range k.GetSolvency(ctx) {
+			if solvency.Margin.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\tmodule account balance %s%s is lower than the unclaimed amount %s%s\n",
+					solvency.Balance, solvency.Denom, solvency.Unclaimed, solvency.Denom)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "module account solvency",
+			fmt.Sprintf("found %d over-allocated denoms\n%s", count, msg)), broken
+	}
+}
+
+// ClaimedAmountsInvariant checks that no allocation or merkle airdrop claimed more than its amount
+func ClaimedAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, allocation := range k.GetAllAllocations(ctx) {
+			if !allocation.ClaimedAmount.IsNil() && allocation.ClaimedAmount.Amount.GT(allocation.Amount.Amount) {
+				count++
+				msg += fmt.Sprintf("\tallocation of %s claimed %s out of %s\n",
+					allocation.Address, allocation.ClaimedAmount, allocation.Amount)
+			}
+		}
+		for _, airdrop := range k.GetAllMerkleAirdrops(ctx) {
+			if !airdrop.ClaimedAmount.IsNil() && airdrop.ClaimedAmount.Amount.GT(airdrop.TotalAmount.Amount) {
+				count++
+				msg += fmt.Sprintf("\tmerkle airdrop %d claimed %s out of %s\n",
+					airdrop.Id, airdrop.ClaimedAmount, airdrop.TotalAmount)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "claimed amounts",
+			fmt.Sprintf("found %d allocations claiming more than their amount\n%s", count, msg)), broken
+	}
+}
+
+// AllocationDenomsInvariant checks that allocations and merkle airdrops are claimed in the denom of
+// their amount, which is the denom of their campaign
+func AllocationDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		campaignDenoms := map[uint64]string{}
+		for _, campaign := range k.GetAllCampaigns(ctx) {
+			campaignDenoms[campaign.Id] = campaign.Denom
+		}
+		checkDenoms := func(name string, campaignId uint64, amount sdk.Coin, claimed sdk.Coin) {
+			if !claimed.IsNil() && !claimed.IsZero() && claimed.Denom != amount.Denom {
+				count++
+				msg += fmt.Sprintf("\t%s claimed %s out of %s\n", name, claimed, amount)
+			}
+			if denom, ok := campaignDenoms[campaignId]; ok && denom != amount.Denom {
+				count++
+				msg += fmt.Sprintf("\t%s allocates %s in campaign %d of %s\n", name, amount, campaignId, denom)
+			}
+		}
+
+		for _, allocation := range k.GetAllAllocations(ctx) {
+			checkDenoms(fmt.Sprintf("allocation of %s", allocation.Address), allocation.CampaignId, allocation.Amount, allocation.ClaimedAmount)
+		}
+		for _, airdrop := range k.GetAllMerkleAirdrops(ctx) {
+			checkDenoms(fmt.Sprintf("merkle airdrop %d", airdrop.Id), airdrop.CampaignId, airdrop.TotalAmount, airdrop.ClaimedAmount)
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "allocation denoms",
+			fmt.Sprintf("found %d allocations with mismatched denoms\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	ctx := suite.ctx
+	k := suite.app.AirdropKeeper
+
+	_, broken := keeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	allocation := types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       "cosmos1allocation",
+		Amount:        sdk.NewInt64Coin("ufury", 800),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+	}
+	k.SetAllocation(ctx, allocation)
+	_, err := k.CreateMerkleAirdrop(ctx, "00", sdk.NewInt64Coin("ufury", 300), 0)
+	suite.Require().NoError(err)
+
+	// the solvency query reports the margin of every denom
+	res, err := k.Solvency(sdk.WrapSDKContext(ctx), &types.QuerySolvencyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Solvency, 1)
+	suite.Require().Equal("ufury", res.Solvency[0].Denom)
+	suite.Require().Equal("1000", res.Solvency[0].Balance.String())
+	suite.Require().Equal("1000", res.Solvency[0].Unclaimed.String())
+	suite.Require().True(res.Solvency[0].Margin.IsZero())
+
+	allocation.Amount = sdk.NewInt64Coin("ufury", 801)
+	k.SetAllocation(ctx, allocation)
+	suite.Require().Equal("-1", k.GetSolvency(ctx)[0].Margin.String())
+	_, broken = keeper.ModuleAccountSolvencyInvariant(k)(ctx)
+	suite.Require().True(broken)
+	_, broken = keeper.AllInvariants(k)(ctx)
+	suite.Require().True(broken)
+
+	allocation.Amount = sdk.NewInt64Coin("ufury", 800)
+	allocation.ClaimedAmount = sdk.NewInt64Coin("ufury", 900)
+	k.SetAllocation(ctx, allocation)
+	_, broken = keeper.ClaimedAmountsInvariant(k)(ctx)
+	suite.Require().True(broken)
+
+	allocation.ClaimedAmount = sdk.NewInt64Coin("uatom", 100)
+	k.SetAllocation(ctx, allocation)
+	_, broken = keeper.ClaimedAmountsInvariant(k)(ctx)
+	suite.Require().False(broken)
+	_, broken = keeper.AllocationDenomsInvariant(k)(ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *KeeperTestSuite) TestSetAllocationKeepsInvariants() {
+	ctx := suite.ctx
+	k := suite.app.AirdropKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}))
+	res, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(owner, "ufury", time.Time{}, time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(owner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, res.Id))
+	suite.Require().NoError(err)
+
+	// a campaign owner cannot store an allocation that breaks an invariant
+	for _, claimedAmount := range []sdk.Coin{
+		{},
+		sdk.NewInt64Coin("ufury", 101),
+		sdk.NewInt64Coin("uatom", 10),
+	} {
+		msg := types.NewMsgSetAllocation(owner.String(), types.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       "cosmos1allocation",
+			Amount:        sdk.NewInt64Coin("ufury", 100),
+			ClaimedAmount: claimedAmount,
+			CampaignId:    res.Id,
+		})
+		suite.Require().ErrorIs(msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+		_, err = msgServer.SetAllocation(wctx, msg)
+		suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	}
+	suite.Require().Empty(k.GetAllAllocations(ctx))
+
+	_, err = msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(owner.String(), types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       "cosmos1allocation",
+		Amount:        sdk.NewInt64Coin("ufury", 100),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+		CampaignId:    res.Id,
+	}))
+	suite.Require().NoError(err)
+	_, broken := keeper.AllInvariants(k)(ctx)
+	suite.Require().False(broken)
+}
@@ -8,13 +8,25 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	simapp "github.com/furysport/fury-chain/app"
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+	minttypes "github.com/furysport/fury-chain/x/mint/types"
 )
 
 const (
-	isCheckTx = false
+	isCheckTx   = false
+	testChainId = "furya-test-1"
 )
 
 type KeeperTestSuite struct {
@@ -29,10 +41,63 @@ func (suite *KeeperTestSuite) SetupTest() {
 	app := simapp.Setup(isCheckTx)
 
 	suite.legacyAmino = app.LegacyAmino()
-	suite.ctx = app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
+	suite.ctx = app.BaseApp.NewContext(isCheckTx, tmproto.Header{ChainID: testChainId})
 	suite.app = app
 }
 
+func (suite *KeeperTestSuite) fundModuleAccount(coins sdk.Coins) {
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, coins)
+	suite.Require().NoError(err)
+}
+
+// deleteParams deletes params from the param store, as on chains upgraded before they existed.
+func (suite *KeeperTestSuite) deleteParams(keys ...[]byte) {
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// createValidator creates a validator self delegating selfBond of the bond denom.
+func (suite *KeeperTestSuite) createValidator(selfBond int64) sdk.ValAddress {
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bond := sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), selfBond)
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.Coins{bond})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sdk.AccAddress(valAddr), sdk.Coins{bond})
+	suite.Require().NoError(err)
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), bond,
+		stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	return valAddr
+}
+
+// cosmosClaimer returns a new cosmos chain address, its hex public key and a function signing
+// ADR-036 claims of the address to a reward address for a campaign of the test chain.
+func (suite *KeeperTestSuite) cosmosClaimer() (string, string, func(rewardAddr string, campaignId uint64) string) {
+	privKey := secp256k1.GenPrivKey()
+	address, err := bech32.ConvertAndEncode("cosmos", privKey.PubKey().Address())
+	suite.Require().NoError(err)
+
+	sign := func(rewardAddr string, campaignId uint64) string {
+		signBytes, err := keeper.GetSignBytes(testChainId, campaignId, "cosmos", address, rewardAddr)
+		suite.Require().NoError(err)
+		signature, err := privKey.Sign(keeper.ADR036SignBytes(address, signBytes))
+		suite.Require().NoError(err)
+		return hexutil.Encode(signature)
+	}
+	return address, hexutil.Encode(privKey.PubKey().Bytes()), sign
+}
+
 func TestKeeperSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetLastMerkleAirdropId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastMerkleAirdropId)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastMerkleAirdropId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastMerkleAirdropId, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetMerkleAirdrop(ctx sdk.Context, id uint64) *types.MerkleAirdrop {
+	airdrop := types.MerkleAirdrop{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMerkleAirdropKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &airdrop)
+	return &airdrop
+}
+
+func (k Keeper) GetAllMerkleAirdrops(ctx sdk.Context) []types.MerkleAirdrop {
+	airdrops := []types.MerkleAirdrop{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleAirdrop)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		airdrop := types.MerkleAirdrop{}
+		k.cdc.MustUnmarshal(iterator.Value(), &airdrop)
+
+		airdrops = append(airdrops, airdrop)
+	}
+	return airdrops
+}
+
+// SetMerkleAirdrop stores the merkle airdrop, updating the amount reserved by its unclaimed leaves
+func (k Keeper) SetMerkleAirdrop(ctx sdk.Context, airdrop types.MerkleAirdrop) {
+	if prev := k.GetMerkleAirdrop(ctx, airdrop.Id); prev != nil {
+		k.addReservedAmount(ctx, prev.CampaignId, prev.TotalAmount.Denom, unclaimedMerkleAmount(*prev).Neg())
+	}
+	k.addReservedAmount(ctx, airdrop.CampaignId, airdrop.TotalAmount.Denom, unclaimedMerkleAmount(airdrop))
+
+	bz := k.cdc.MustMarshal(&airdrop)
+	ctx.KVStore(k.storeKey).Set(types.GetMerkleAirdropKey(airdrop.Id), bz)
+}
+
+// CreateMerkleAirdrop stores a new merkle airdrop and returns its id, its total amount is reserved
+// from the unallocated balance of the campaign
+func (k Keeper) CreateMerkleAirdrop(ctx sdk.Context, merkleRoot string, totalAmount sdk.Coin, campaignId uint64) (uint64, error) {
+	if err := k.EnsureUnallocated(ctx, campaignId, totalAmount); err != nil {
+		return 0, err
+	}
+
+	id := k.GetLastMerkleAirdropId(ctx) + 1
+	k.SetLastMerkleAirdropId(ctx, id)
+	k.SetMerkleAirdrop(ctx, types.MerkleAirdrop{
+		Id:            id,
+		MerkleRoot:    merkleRoot,
+		TotalAmount:   totalAmount,
+		CampaignId:    campaignId,
+		ClaimedAmount: sdk.NewCoin(totalAmount.Denom, sdk.ZeroInt()),
+	})
+	return id, nil
+}
+
+func (k Keeper) GetMerkleClaimedWord(ctx sdk.Context, id uint64, wordIndex uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMerkleClaimedWordKey(id, wordIndex))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) GetAllMerkleClaimedWords(ctx sdk.Context) []types.MerkleClaimedWord {
+	words := []types.MerkleClaimedWord{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleClaimedWord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.KeyPrefixMerkleClaimedWord):]
+		words = append(words, types.MerkleClaimedWord{
+			AirdropId: sdk.BigEndianToUint64(key[:8]),
+			WordIndex: sdk.BigEndianToUint64(key[8:]),
+			Bits:      sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return words
+}
+
+func (k Keeper) SetMerkleClaimedWord(ctx sdk.Context, word types.MerkleClaimedWord) {
+	ctx.KVStore(k.storeKey).Set(types.GetMerkleClaimedWordKey(word.AirdropId, word.WordIndex), sdk.Uint64ToBigEndian(word.Bits))
+}
+
+func (k Keeper) IsMerkleLeafClaimed(ctx sdk.Context, id uint64, index uint64) bool {
+	word := k.GetMerkleClaimedWord(ctx, id, index/64)
+	return word&(1<<(index%64)) != 0
+}
+
+func (k Keeper) SetMerkleLeafClaimed(ctx sdk.Context, id uint64, index uint64) {
+	k.SetMerkleClaimedWord(ctx, types.MerkleClaimedWord{
+		AirdropId: id,
+		WordIndex: index / 64,
+		Bits:      k.GetMerkleClaimedWord(ctx, id, index/64) | 1<<(index%64),
+	})
+}
+
+// ClaimMerkleLeaf verifies the merkle claim of an address, marks its leaf as claimed and adds the proven
+// amount to the allocation of the address so that it can be claimed like any other allocation of the campaign.
+func (k Keeper) ClaimMerkleLeaf(ctx sdk.Context, campaignId uint64, address string, claim types.MerkleClaim) error {
+	airdrop := k.GetMerkleAirdrop(ctx, claim.AirdropId)
+	if airdrop == nil {
+		return types.ErrMerkleAirdropDoesNotExists
+	}
+	if airdrop.CampaignId != campaignId {
+		return sdkerrors.Wrapf(types.ErrMerkleAirdropDoesNotExists, "merkle airdrop %d is not an airdrop of campaign %d", claim.AirdropId, campaignId)
+	}
+
+	if err := k.EnsureCampaignDenom(ctx, airdrop.CampaignId, claim.Amount.Denom); err != nil {
+		return err
+	}
+	if claim.Amount.Denom != airdrop.TotalAmount.Denom {
+		return types.ErrInvalidCampaignDenom
+	}
+
+	// leaves of airdrop files built before addresses were normalized commit to the address as listed
+	leaf := types.MerkleLeafHash(claim.Index, claim.Chain, types.NormalizeAddress(address), claim.Amount)
+	if !types.VerifyMerkleProof(airdrop.MerkleRoot, leaf, claim.Proof) {
+		leaf = types.MerkleLeafHash(claim.Index, claim.Chain, address, claim.Amount)
+		if !types.VerifyMerkleProof(airdrop.MerkleRoot, leaf, claim.Proof) {
+			return types.ErrInvalidMerkleProof
+		}
+	}
+
+	if k.IsMerkleLeafClaimed(ctx, claim.AirdropId, claim.Index) {
+		return types.ErrMerkleLeafAlreadyClaimed
+	}
+
+	// the leaf amount adds up to the allocation the address already has in the campaign
+	allocation := k.GetAllocation(ctx, airdrop.CampaignId, address)
+	if allocation == nil {
+		allocation = &types.AirdropAllocation{
+			Chain:         claim.Chain,
+			Address:       address,
+			Amount:        sdk.NewCoin(claim.Amount.Denom, sdk.ZeroInt()),
+			ClaimedAmount: sdk.NewCoin(claim.Amount.Denom, sdk.ZeroInt()),
+			CampaignId:    airdrop.CampaignId,
+		}
+	}
+	if allocation.Chain != claim.Chain {
+		return sdkerrors.Wrapf(types.ErrAirdropAllocationAlreadyExists, "allocation of %s is on chain %s", address, allocation.Chain)
+	}
+	if allocation.Amount.Denom != claim.Amount.Denom {
+		return types.ErrInvalidCampaignDenom
+	}
+
+	// move the leaf amount from the airdrop reservation to the allocation
+	if airdrop.ClaimedAmount.IsNil() {
+		airdrop.ClaimedAmount = sdk.NewCoin(claim.Amount.Denom, sdk.ZeroInt())
+	}
+	airdrop.ClaimedAmount = airdrop.ClaimedAmount.Add(claim.Amount)
+	k.SetMerkleAirdrop(ctx, *airdrop)
+
+	k.SetMerkleLeafClaimed(ctx, claim.AirdropId, claim.Index)
+	allocation.Amount = allocation.Amount.Add(claim.Amount)
+	k.SetAllocation(ctx, *allocation)
+	return nil
+}
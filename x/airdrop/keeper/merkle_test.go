@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimMerkleAllocation() {
+	addrs := []sdk.AccAddress{}
+	cosmosAddrs := []string{}
+	pubKeys := []string{}
+	signers := []func(string, uint64) string{}
+	claims := []types.MerkleClaim{}
+	leaves := [][]byte{}
+	for i := 0; i < 3; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+		claim := types.MerkleClaim{
+			Index:  uint64(i),
+			Chain:  "cosmos",
+			Amount: sdk.NewInt64Coin("ufury", int64(4000*(i+1))),
+		}
+		addrs = append(addrs, addr)
+		cosmosAddrs = append(cosmosAddrs, cosmosAddr)
+		pubKeys = append(pubKeys, pubKey)
+		signers = append(signers, sign)
+		claims = append(claims, claim)
+		leaves = append(leaves, types.MerkleLeafHash(claim.Index, claim.Chain, cosmosAddr, claim.Amount))
+	}
+
+	root, proofs := types.BuildMerkleTree(leaves)
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 24000)})
+
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+	owner := suite.app.AirdropKeeper.GetParamSet(suite.ctx).Owner
+	res, err := msgServer.CreateMerkleAirdrop(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateMerkleAirdrop{
+		Sender:      owner,
+		MerkleRoot:  hex.EncodeToString(root),
+		TotalAmount: sdk.NewInt64Coin("ufury", 24000),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+
+	for i, addr := range addrs {
+		cosmosAddr := cosmosAddrs[i]
+		signature := signers[i](addr.String(), 0)
+		claim := claims[i]
+		claim.AirdropId = res.Id
+		for _, sibling := range proofs[i] {
+			claim.Proof = append(claim.Proof, hex.EncodeToString(sibling))
+		}
+
+		// claiming another leaf amount is rejected
+		tampered := claim
+		tampered.Amount = sdk.NewInt64Coin("ufury", 100000)
+		_, err = msgServer.ClaimAllocation(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimAllocation{
+			Address:       cosmosAddr,
+			PubKey:        pubKeys[i],
+			RewardAddress: addr.String(),
+			Signature:     signature,
+			MerkleClaim:   &tampered,
+		})
+		suite.Require().ErrorIs(err, types.ErrInvalidMerkleProof)
+
+		_, err = msgServer.ClaimAllocation(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimAllocation{
+			Address:       cosmosAddr,
+			PubKey:        pubKeys[i],
+			RewardAddress: addr.String(),
+			Signature:     signature,
+			MerkleClaim:   &claim,
+		})
+		suite.Require().NoError(err)
+		suite.Require().True(suite.app.AirdropKeeper.IsMerkleLeafClaimed(suite.ctx, res.Id, claim.Index))
+		suite.Require().Equal(claim.Amount.Amount.QuoRaw(4), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount)
+
+		_, err = msgServer.ClaimAllocation(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimAllocation{
+			Address:       cosmosAddr,
+			PubKey:        pubKeys[i],
+			RewardAddress: addr.String(),
+			Signature:     signature,
+			MerkleClaim:   &claim,
+		})
+		suite.Require().ErrorIs(err, types.ErrMerkleLeafAlreadyClaimed)
+	}
+
+	words := suite.app.AirdropKeeper.GetAllMerkleClaimedWords(suite.ctx)
+	suite.Require().Equal([]types.MerkleClaimedWord{{AirdropId: 1, WordIndex: 0, Bits: 7}}, words)
+}
+
+func (suite *KeeperTestSuite) TestClaimMerkleLeavesOfAllocatedAddress() {
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cosmosAddr, pubKey, sign := suite.cosmosClaimer()
+
+	// the address has a direct allocation and two leaves of a merkle airdrop
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 12000)})
+	suite.app.AirdropKeeper.SetAllocation(suite.ctx, types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       cosmosAddr,
+		Amount:        sdk.NewInt64Coin("ufury", 4000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+	})
+	claims := []types.MerkleClaim{
+		{Index: 0, Chain: "cosmos", Amount: sdk.NewInt64Coin("ufury", 4000)},
+		{Index: 1, Chain: "cosmos", Amount: sdk.NewInt64Coin("ufury", 4000)},
+	}
+	leaves := [][]byte{}
+	for _, claim := range claims {
+		leaves = append(leaves, types.MerkleLeafHash(claim.Index, claim.Chain, cosmosAddr, claim.Amount))
+	}
+	root, proofs := types.BuildMerkleTree(leaves)
+	id, err := suite.app.AirdropKeeper.CreateMerkleAirdrop(suite.ctx, hex.EncodeToString(root), sdk.NewInt64Coin("ufury", 8000), 0)
+	suite.Require().NoError(err)
+
+	// every leaf adds its amount to the allocation
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+	for i, claim := range claims {
+		claim.AirdropId = id
+		for _, sibling := range proofs[i] {
+			claim.Proof = append(claim.Proof, hex.EncodeToString(sibling))
+		}
+		_, err = msgServer.ClaimAllocation(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimAllocation{
+			Address:       cosmosAddr,
+			PubKey:        pubKey,
+			RewardAddress: addr.String(),
+			Signature:     sign(addr.String(), 0),
+			MerkleClaim:   &claim,
+		})
+		suite.Require().NoError(err)
+
+		allocation := suite.app.AirdropKeeper.GetAllocation(suite.ctx, 0, cosmosAddr)
+		suite.Require().Equal(sdk.NewInt64Coin("ufury", int64(4000*(i+2))), allocation.Amount)
+		suite.Require().Equal(allocation.Amount.Amount.QuoRaw(4), suite.app.BankKeeper.GetBalance(suite.ctx, addr, "ufury").Amount)
+	}
+
+	airdrop := suite.app.AirdropKeeper.GetMerkleAirdrop(suite.ctx, id)
+	suite.Require().Equal(airdrop.TotalAmount, airdrop.ClaimedAmount)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store of version 1: allocations and claim records are re-keyed by campaign and
+// normalized address, the amounts reserved by allocations of each campaign are seeded, and the params added
+// since version 1 are set.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.normalizeAddresses(ctx); err != nil {
+		return err
+	}
+	m.keyByCampaign(ctx)
+	m.keeper.ResetReservedAmounts(ctx)
+	m.setMissingParams(ctx)
+	return nil
+}
+
+// normalizeAddresses re-keys allocations and claim records stored under addresses that are not normalized.
+// An allocation colliding with the allocation of its normalized address is merged into it, summing their
+// amounts and claimed amounts, or deleted when they belong to different campaigns or denoms. A colliding
+// claim record is deleted, the claim record of the normalized address is kept.
+func (m Migrator) normalizeAddresses(ctx sdk.Context) error {
+	k := m.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	allocationStore := prefix.NewStore(store, types.KeyPrefixAirdropAllocation)
+	for _, allocation := range k.GetAllAllocations(ctx) {
+		normalized := types.NormalizeAddress(allocation.Address)
+		if normalized == allocation.Address {
+			continue
+		}
+		allocationStore.Delete([]byte(allocation.Address))
+		if bz := allocationStore.Get([]byte(normalized)); bz != nil {
+			existing := types.AirdropAllocation{}
+			k.cdc.MustUnmarshal(bz, &existing)
+			if existing.CampaignId != allocation.CampaignId || existing.Amount.Denom != allocation.Amount.Denom {
+				k.Logger(ctx).Error("deleted allocation colliding with the allocation of its normalized address", "address", allocation.Address, "normalized", normalized)
+				continue
+			}
+			claimed := sdk.ZeroInt()
+			for _, claimedAmount := range []sdk.Coin{existing.ClaimedAmount, allocation.ClaimedAmount} {
+				if !claimedAmount.IsNil() {
+					claimed = claimed.Add(claimedAmount.Amount)
+				}
+			}
+			existing.Amount = existing.Amount.Add(allocation.Amount)
+			existing.ClaimedAmount = sdk.NewCoin(existing.Amount.Denom, claimed)
+			allocation = existing
+		}
+		allocation.Address = normalized
+		allocationStore.Set([]byte(normalized), k.cdc.MustMarshal(&allocation))
+	}
+
+	recordStore := prefix.NewStore(store, types.KeyPrefixClaimRecord)
+	for _, record := range k.GetAllClaimRecords(ctx) {
+		normalized := types.NormalizeAddress(record.Address)
+		if normalized == record.Address {
+			continue
+		}
+		rewardAddr, err := sdk.AccAddressFromBech32(record.RewardAddress)
+		if err != nil {
+			return err
+		}
+		recordStore.Delete([]byte(record.Address))
+		store.Delete(append(types.GetClaimRecordByRewardAddressPrefix(rewardAddr), record.Address...))
+		if recordStore.Has([]byte(normalized)) {
+			k.Logger(ctx).Error("deleted claim record colliding with the claim record of its normalized address", "address", record.Address, "normalized", normalized)
+			continue
+		}
+		record.Address = normalized
+		recordStore.Set([]byte(normalized), k.cdc.MustMarshal(&record))
+		store.Set(append(types.GetClaimRecordByRewardAddressPrefix(rewardAddr), normalized...), []byte{})
+	}
+	return nil
+}
+
+// keyByCampaign re-keys allocations and claim records, keyed by address in version 1, by campaign and address,
+// so that campaigns allocating to the same address do not overwrite each other. Claim records are moved to the
+// campaign of the allocation of their address.
+func (m Migrator) keyByCampaign(ctx sdk.Context) {
+	k := m.keeper
+	store := ctx.KVStore(k.storeKey)
+
+	allocations := k.GetAllAllocations(ctx)
+	records := k.GetAllClaimRecords(ctx)
+	deletePrefix(store, types.KeyPrefixAirdropAllocation)
+	deletePrefix(store, types.KeyPrefixClaimRecord)
+	deletePrefix(store, types.KeyPrefixClaimRecordByRewardAddress)
+
+	campaignIds := map[string]uint64{}
+	for _, allocation := range allocations {
+		campaignIds[allocation.Address] = allocation.CampaignId
+		store.Set(types.GetAllocationKey(allocation.CampaignId, allocation.Address), k.cdc.MustMarshal(&allocation))
+	}
+	for _, record := range records {
+		record.CampaignId = campaignIds[record.Address]
+		k.SetClaimRecord(ctx, record)
+	}
+}
+
+// setMissingParams sets the params missing from the param store of version 1, which read as zero: the legacy
+// sign message deadline, which would reject every legacy signature, is set to LegacySignMessageUpgradePeriod
+// after the upgrade, and the fee-less claim limits, which would be disabled, are set to their defaults.
+func (m Migrator) setMissingParams(ctx sdk.Context) {
+	k := m.keeper
+	params := k.GetParamSet(ctx)
+	defaults := types.DefaultParams()
+	if !k.paramSpace.Has(ctx, types.KeyLegacySignMessageDeadline) {
+		params.LegacySignMessageDeadline = ctx.BlockTime().Add(types.LegacySignMessageUpgradePeriod)
+	}
+	if !k.paramSpace.Has(ctx, types.KeyMaxFeelessClaimsPerBlock) {
+		params.MaxFeelessClaimsPerBlock = defaults.MaxFeelessClaimsPerBlock
+	}
+	if !k.paramSpace.Has(ctx, types.KeyFeelessClaimGasLimit) {
+		params.FeelessClaimGasLimit = defaults.FeelessClaimGasLimit
+	}
+	k.SetParamSet(ctx, params)
+}
+
+// deletePrefix deletes all the keys of the store starting with the prefix
+func deletePrefix(store sdk.KVStore, prefix []byte) {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
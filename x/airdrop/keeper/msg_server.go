@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/furysport/fury-chain/x/airdrop/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -21,36 +23,93 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-func (k msgServer) ClaimAllocation(goCtx context.Context, msg *types.MsgClaimAllocation) (*types.MsgClaimAllocationResponse, error) {
+func (m msgServer) ClaimAllocation(goCtx context.Context, msg *types.MsgClaimAllocation) (*types.MsgClaimAllocationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err := k.keeper.ClaimAllocation(ctx, msg.Address, msg.PubKey, msg.RewardAddress, msg.Signature)
+	if msg.MerkleClaim != nil {
+		err := m.keeper.ClaimMerkleLeaf(ctx, msg.CampaignId, msg.Address, *msg.MerkleClaim)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := m.keeper.ClaimAllocation(ctx, *msg)
 	return &types.MsgClaimAllocationResponse{}, err
 }
 
-func (k msgServer) SetAllocation(goCtx context.Context, msg *types.MsgSetAllocation) (*types.MsgSetAllocationResponse, error) {
+func (m msgServer) SetAllocation(goCtx context.Context, msg *types.MsgSetAllocation) (*types.MsgSetAllocationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	params := k.keeper.GetParamSet(ctx)
-	if msg.Sender != params.Owner {
-		return nil, types.ErrNotEnoughPermission
+	if err := msg.Allocation.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-	k.keeper.SetAllocation(ctx, msg.Allocation)
+	if err := m.keeper.EnsureOwner(ctx, msg.Allocation.CampaignId, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.EnsureCampaignDenom(ctx, msg.Allocation.CampaignId, msg.Allocation.Amount.Denom); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.EnsureAllocationFunded(ctx, msg.Allocation); err != nil {
+		return nil, err
+	}
+	m.keeper.SetAllocation(ctx, msg.Allocation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAllocation,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Allocation.Address),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", msg.Allocation.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Allocation.Amount.String()),
+		),
+	)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventSetAllocation{
+		Sender:     msg.Sender,
+		Chain:      msg.Allocation.Chain,
+		Address:    types.NormalizeAddress(msg.Allocation.Address),
+		CampaignId: msg.Allocation.CampaignId,
+		Amount:     msg.Allocation.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetAllocationResponse{}, nil
 }
 
-func (m msgServer) TransferModuleOwnership(goCtx context.Context, msg *types.MsgTransferModuleOwnership) (*types.MsgTransferModuleOwnershipResponse, error) {
+func (m msgServer) SetAllocations(goCtx context.Context, msg *types.MsgSetAllocations) (*types.MsgSetAllocationsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	params := m.keeper.GetParamSet(ctx)
-	if msg.Sender != params.Owner {
-		return nil, types.ErrNotEnoughPermission
+
+	upload, err := m.keeper.UploadAllocations(ctx, *msg)
+	if err != nil {
+		return nil, err
 	}
-	params.Owner = msg.NewOwner
-	m.keeper.SetParamSet(ctx, params)
+	return &types.MsgSetAllocationsResponse{
+		Checksum: upload.Checksum,
+		Count:    upload.Count,
+	}, nil
+}
 
+func (m msgServer) TransferModuleOwnership(goCtx context.Context, msg *types.MsgTransferModuleOwnership) (*types.MsgTransferModuleOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := m.keeper.TransferModuleOwnership(ctx, msg.Sender, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
 	return &types.MsgTransferModuleOwnershipResponse{}, nil
 }
 
+func (m msgServer) AcceptModuleOwnership(goCtx context.Context, msg *types.MsgAcceptModuleOwnership) (*types.MsgAcceptModuleOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := m.keeper.AcceptModuleOwnership(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptModuleOwnershipResponse{}, nil
+}
+
 func (m msgServer) DepositTokens(goCtx context.Context, msg *types.MsgDepositTokens) (*types.MsgDepositTokensResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -64,5 +123,107 @@ func (m msgServer) DepositTokens(goCtx context.Context, msg *types.MsgDepositTok
 		return nil, err
 	}
 
+	if msg.CampaignId != 0 {
+		err = m.keeper.FundCampaign(ctx, msg.CampaignId, msg.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositTokens,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", msg.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.Coins(msg.Amount).String()),
+		),
+	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDeposit{
+		Sender:     msg.Sender,
+		CampaignId: msg.CampaignId,
+		Amount:     msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDepositTokensResponse{}, nil
 }
+
+func (m msgServer) CreateMerkleAirdrop(goCtx context.Context, msg *types.MsgCreateMerkleAirdrop) (*types.MsgCreateMerkleAirdropResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.EnsureOwner(ctx, msg.CampaignId, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := m.keeper.EnsureCampaignDenom(ctx, msg.CampaignId, msg.TotalAmount.Denom); err != nil {
+		return nil, err
+	}
+
+	id, err := m.keeper.CreateMerkleAirdrop(ctx, msg.MerkleRoot, msg.TotalAmount, msg.CampaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateMerkleAirdrop,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAirdropId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", msg.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyMerkleRoot, msg.MerkleRoot),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.TotalAmount.String()),
+		),
+	)
+
+	return &types.MsgCreateMerkleAirdropResponse{Id: id}, nil
+}
+
+func (m msgServer) CreateCampaign(goCtx context.Context, msg *types.MsgCreateCampaign) (*types.MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id := m.keeper.CreateCampaign(ctx, msg.Sender, msg.Denom, msg.StartTime, msg.EndTime, msg.Vesting)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	)
+
+	return &types.MsgCreateCampaignResponse{Id: id}, nil
+}
+
+func (m msgServer) RevokeAllocation(goCtx context.Context, msg *types.MsgRevokeAllocation) (*types.MsgRevokeAllocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revoked, err := m.keeper.RevokeAllocation(ctx, msg.Sender, msg.CampaignId, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRevokeAllocationResponse{Amount: revoked}, nil
+}
+
+func (m msgServer) WithdrawTokens(goCtx context.Context, msg *types.MsgWithdrawTokens) (*types.MsgWithdrawTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := m.keeper.WithdrawTokens(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawTokensResponse{}, nil
+}
+
+func (m msgServer) CreateSnapshotCampaign(goCtx context.Context, msg *types.MsgCreateSnapshotCampaign) (*types.MsgCreateSnapshotCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Sender != types.GovModuleAddress().String() {
+		return nil, types.ErrNotEnoughPermission
+	}
+
+	snapshot, err := m.keeper.CreateSnapshotCampaign(ctx, msg.Owner, msg.StartTime, msg.EndTime, msg.Vesting, msg.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCreateSnapshotCampaignResponse{CampaignId: snapshot.CampaignId, Count: snapshot.Count}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// GetPendingOwner returns the proposed module owner, empty if no transfer is pending
+func (k Keeper) GetPendingOwner(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.KeyPendingOwner))
+}
+
+func (k Keeper) SetPendingOwner(ctx sdk.Context, owner string) {
+	store := ctx.KVStore(k.storeKey)
+	if owner == "" {
+		store.Delete(types.KeyPendingOwner)
+		return
+	}
+	store.Set(types.KeyPendingOwner, []byte(owner))
+}
+
+// TransferModuleOwnership proposes a new module owner, the current owner stays in charge until the
+// new owner accepts the ownership. An empty new owner cancels the pending transfer.
+func (k Keeper) TransferModuleOwnership(ctx sdk.Context, sender string, newOwner string) error {
+	if sender != k.GetParamSet(ctx).Owner {
+		return types.ErrNotEnoughPermission
+	}
+	k.SetPendingOwner(ctx, newOwner)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferOwnership,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner),
+		),
+	)
+	return nil
+}
+
+// AcceptModuleOwnership makes the pending owner the module owner
+func (k Keeper) AcceptModuleOwnership(ctx sdk.Context, sender string) error {
+	if pending := k.GetPendingOwner(ctx); pending == "" || sender != pending {
+		return types.ErrNotPendingOwner
+	}
+
+	params := k.GetParamSet(ctx)
+	previous := params.Owner
+	params.Owner = sender
+	k.SetParamSet(ctx, params)
+	k.SetPendingOwner(ctx, "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptOwnership,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyOwner, previous),
+		),
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventOwnershipTransferred{
+		PreviousOwner: previous,
+		NewOwner:      sender,
+	})
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop"
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestModuleOwnershipTransfer() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newOwner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	params := suite.app.AirdropKeeper.GetParamSet(ctx)
+	params.Owner = owner.String()
+	suite.app.AirdropKeeper.SetParamSet(ctx, params)
+
+	// only the owner proposes a new owner, which does not take over before accepting
+	_, err := msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(newOwner, newOwner.String()))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	_, err = msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(owner, newOwner.String()))
+	suite.Require().NoError(err)
+	res, err := suite.app.AirdropKeeper.Ownership(wctx, &types.QueryOwnershipRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner.String(), res.Owner)
+	suite.Require().Equal(newOwner.String(), res.PendingOwner)
+
+	_, err = msgServer.AcceptModuleOwnership(wctx, types.NewMsgAcceptModuleOwnership(owner))
+	suite.Require().ErrorIs(err, types.ErrNotPendingOwner)
+
+	// a mistyped owner is replaced or cancelled by the current owner
+	_, err = msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(owner, ""))
+	suite.Require().NoError(err)
+	_, err = msgServer.AcceptModuleOwnership(wctx, types.NewMsgAcceptModuleOwnership(newOwner))
+	suite.Require().ErrorIs(err, types.ErrNotPendingOwner)
+
+	_, err = msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(owner, newOwner.String()))
+	suite.Require().NoError(err)
+	_, err = msgServer.AcceptModuleOwnership(wctx, types.NewMsgAcceptModuleOwnership(newOwner))
+	suite.Require().NoError(err)
+	suite.Require().Equal(newOwner.String(), suite.app.AirdropKeeper.GetParamSet(ctx).Owner)
+	suite.Require().Empty(suite.app.AirdropKeeper.GetPendingOwner(ctx))
+
+	// messages of contract owners are routed through the msg service router
+	suite.Require().NotNil(suite.app.MsgServiceRouter().Handler(types.NewMsgAcceptModuleOwnership(newOwner)))
+}
+
+func (suite *KeeperTestSuite) TestGovernanceOwnedModule() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+	handler := airdrop.NewOwnerProposalHandler(suite.app.AirdropKeeper)
+	gov := types.GovModuleAddress()
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	params := suite.app.AirdropKeeper.GetParamSet(ctx)
+	params.Owner = owner.String()
+	suite.app.AirdropKeeper.SetParamSet(ctx, params)
+	reserved := suite.app.AirdropKeeper.GetReservedAmount(ctx, 0, "ufury")
+	suite.fundModuleAccount(sdk.Coins{reserved.AddAmount(sdk.NewInt(1000))})
+
+	// governance accepts the ownership with a proposal
+	_, err := msgServer.TransferModuleOwnership(wctx, types.NewMsgTransferModuleOwnership(owner, gov.String()))
+	suite.Require().NoError(err)
+	proposal, err := types.NewOwnerMsgsProposal("accept", "accept the airdrop ownership", []sdk.Msg{types.NewMsgAcceptModuleOwnership(gov)})
+	suite.Require().NoError(err)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(handler(ctx, proposal))
+	suite.Require().Equal(gov.String(), suite.app.AirdropKeeper.GetParamSet(ctx).Owner)
+
+	// owner pool operations are executed by proposals
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	proposal, err = types.NewOwnerMsgsProposal("withdraw", "withdraw the owner pool", []sdk.Msg{
+		types.NewMsgWithdrawTokens(gov, 0, sdk.Coins{sdk.NewInt64Coin("ufury", 400)}, recipient.String()),
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(handler(ctx, proposal))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), suite.app.BankKeeper.GetBalance(ctx, recipient, "ufury"))
+
+	// messages of other signers are rejected
+	proposal, err = types.NewOwnerMsgsProposal("withdraw", "withdraw the owner pool", []sdk.Msg{
+		types.NewMsgWithdrawTokens(owner, 0, sdk.Coins{sdk.NewInt64Coin("ufury", 400)}, owner.String()),
+	})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(proposal.ValidateBasic(), types.ErrInvalidProposalMsgs)
+	suite.Require().ErrorIs(handler(ctx, proposal), types.ErrInvalidProposalMsgs)
+
+	// deposits would spend the proposal deposits held by the governance module account
+	proposal, err = types.NewOwnerMsgsProposal("deposit", "deposit to the owner pool", []sdk.Msg{
+		types.NewMsgDepositTokens(gov, sdk.Coins{sdk.NewInt64Coin("ufury", 400)}, 0),
+	})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(proposal.ValidateBasic(), types.ErrInvalidProposalMsgs)
+	suite.Require().ErrorIs(handler(ctx, proposal), types.ErrInvalidProposalMsgs)
+}
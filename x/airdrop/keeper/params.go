@@ -5,10 +5,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetParamSet returns token params from the global param store
+// GetParamSet returns token params from the global param store,
+// params added after genesis keep their zero value until set
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var p types.Params
-	k.paramSpace.GetParamSet(ctx, &p)
+	k.paramSpace.GetParamSetIfExists(ctx, &p)
 	return p
 }
 
@@ -12,9 +12,110 @@ import (
 // NewQuerier returns a new sdk.Keeper instance.
 func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		goCtx := sdk.WrapSDKContext(ctx)
+
 		switch path[0] {
+		case types.QueryAllocation:
+			params := types.QueryAllocationRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Allocation(goCtx, &params)
+			})
+
+		case types.QueryClaimRecord:
+			params := types.QueryClaimRecordRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.ClaimRecord(goCtx, &params)
+			})
+
+		case types.QueryClaimReceipts:
+			params := types.QueryClaimReceiptsRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.ClaimReceipts(goCtx, &params)
+			})
+
+		case types.QueryMerkleAirdrop:
+			params := types.QueryMerkleAirdropRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.MerkleAirdrop(goCtx, &params)
+			})
+
+		case types.QueryMerkleLeafClaimed:
+			params := types.QueryMerkleLeafClaimedRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.MerkleLeafClaimed(goCtx, &params)
+			})
+
+		case types.QueryCampaign:
+			params := types.QueryCampaignRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Campaign(goCtx, &params)
+			})
+
+		case types.QueryCampaigns:
+			params := types.QueryCampaignsRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Campaigns(goCtx, &params)
+			})
+
+		case types.QueryAllocationUpload:
+			params := types.QueryAllocationUploadRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.AllocationUpload(goCtx, &params)
+			})
+
+		case types.QueryParams:
+			params := types.QueryParamsRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Params(goCtx, &params)
+			})
+
+		case types.QueryOwnership:
+			params := types.QueryOwnershipRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Ownership(goCtx, &params)
+			})
+
+		case types.QuerySnapshot:
+			params := types.QuerySnapshotRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Snapshot(goCtx, &params)
+			})
+
+		case types.QuerySolvency:
+			params := types.QuerySolvencyRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.Solvency(goCtx, &params)
+			})
+
+		case types.QuerySimulateClaim:
+			params := types.QuerySimulateClaimRequest{}
+			return legacyQuery(legacyQuerierCdc, req, &params, func() (interface{}, error) {
+				return k.SimulateClaim(goCtx, &params)
+			})
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
 	}
 }
+
+// legacyQuery decodes the amino JSON request data into params, runs the gRPC query and returns
+// the amino JSON of its response
+func legacyQuery(legacyQuerierCdc *codec.LegacyAmino, req abci.RequestQuery, params interface{}, query func() (interface{}, error)) ([]byte, error) {
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	res, err := query()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestLegacyQuerier() {
+	ctx := suite.ctx
+	legacyQuerierCdc := suite.app.LegacyAmino()
+	querier := keeper.NewQuerier(suite.app.AirdropKeeper, legacyQuerierCdc)
+
+	allocation := types.AirdropAllocation{
+		Chain:         "cosmos",
+		Address:       "cosmos1allocation",
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 100),
+	}
+	suite.app.AirdropKeeper.SetAllocation(ctx, allocation)
+	campaignId := suite.app.AirdropKeeper.CreateCampaign(ctx, "owner", "ufury", ctx.BlockTime(), ctx.BlockTime(), types.VestingParams{})
+
+	query := func(path string, params interface{}, res interface{}) error {
+		req := abci.RequestQuery{}
+		if params != nil {
+			req.Data = legacyQuerierCdc.MustMarshalJSON(params)
+		}
+		bz, err := querier(ctx, []string{path}, req)
+		if err != nil {
+			return err
+		}
+		return legacyQuerierCdc.UnmarshalJSON(bz, res)
+	}
+
+	allocationRes := types.QueryAllocationResponse{}
+	err := query(types.QueryAllocation, types.QueryAllocationRequest{Address: allocation.Address}, &allocationRes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(allocation, *allocationRes.Allocation)
+
+	campaignRes := types.QueryCampaignResponse{}
+	err = query(types.QueryCampaign, types.QueryCampaignRequest{Id: campaignId}, &campaignRes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.AirdropKeeper.GetCampaign(ctx, campaignId), campaignRes.Campaign)
+
+	paramsRes := types.QueryParamsResponse{}
+	err = query(types.QueryParams, nil, &paramsRes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.AirdropKeeper.GetParamSet(ctx), paramsRes.Params)
+
+	solvencyRes := types.QuerySolvencyResponse{}
+	err = query(types.QuerySolvency, nil, &solvencyRes)
+	suite.Require().NoError(err)
+	suite.Require().Len(solvencyRes.Solvency, 1)
+	suite.Require().Equal("900", solvencyRes.Solvency[0].Unclaimed.String())
+
+	_, err = querier(ctx, []string{types.QueryAllocation}, abci.RequestQuery{Data: []byte("invalid")})
+	suite.Require().ErrorIs(err, sdkerrors.ErrJSONUnmarshal)
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownRequest)
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// GetReservedAmount returns the amount of the campaign balance reserved by unclaimed allocations
+// and unclaimed merkle airdrop leaves.
+func (k Keeper) GetReservedAmount(ctx sdk.Context, campaignId uint64, denom string) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetReservedAmountKey(campaignId, denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	amount := sdk.ZeroInt()
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+func (k Keeper) SetReservedAmount(ctx sdk.Context, campaignId uint64, amount sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(types.GetReservedAmountKey(campaignId, amount.Denom))
+		return
+	}
+
+	bz, err := amount.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetReservedAmountKey(campaignId, amount.Denom), bz)
+}
+
+// addReservedAmount adds a signed change to the reserved amount of the campaign
+func (k Keeper) addReservedAmount(ctx sdk.Context, campaignId uint64, denom string, change sdk.Int) {
+	if change.IsZero() {
+		return
+	}
+
+	reserved := k.GetReservedAmount(ctx, campaignId, denom)
+	reserved.Amount = sdk.MaxInt(reserved.Amount.Add(change), sdk.ZeroInt())
+	k.SetReservedAmount(ctx, campaignId, reserved)
+}
+
+// unclaimedAmount returns the amount of the allocation left to claim
+func unclaimedAmount(allocation types.AirdropAllocation) sdk.Int {
+	if allocation.Amount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	unclaimed := allocation.Amount.Amount
+	if !allocation.ClaimedAmount.IsNil() {
+		unclaimed = unclaimed.Sub(allocation.ClaimedAmount.Amount)
+	}
+	return sdk.MaxInt(unclaimed, sdk.ZeroInt())
+}
+
+// unclaimedMerkleAmount returns the amount of the merkle airdrop not claimed into allocations
+func unclaimedMerkleAmount(airdrop types.MerkleAirdrop) sdk.Int {
+	if airdrop.TotalAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	unclaimed := airdrop.TotalAmount.Amount
+	if !airdrop.ClaimedAmount.IsNil() {
+		unclaimed = unclaimed.Sub(airdrop.ClaimedAmount.Amount)
+	}
+	return sdk.MaxInt(unclaimed, sdk.ZeroInt())
+}
+
+// GetUnallocatedBalance returns the balance of the campaign not reserved by allocations,
+// which the campaign owner can withdraw.
+func (k Keeper) GetUnallocatedBalance(ctx sdk.Context, campaignId uint64, denom string) (sdk.Coin, error) {
+	balance, err := k.GetAvailableBalance(ctx, campaignId, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	reserved := k.GetReservedAmount(ctx, campaignId, denom)
+	if balance.IsLT(reserved) {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	return balance.Sub(reserved), nil
+}
+
+// EnsureUnallocated returns an error if the amount exceeds the unallocated balance of the campaign,
+// so that allocations and merkle airdrops cannot reserve more than the campaign is funded with.
+func (k Keeper) EnsureUnallocated(ctx sdk.Context, campaignId uint64, amount sdk.Coin) error {
+	unallocated, err := k.GetUnallocatedBalance(ctx, campaignId, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if unallocated.IsLT(amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientUnallocatedBalance, "unallocated balance is %s", unallocated)
+	}
+	return nil
+}
+
+// WithdrawTokens sends unallocated tokens of the campaign to the recipient
+func (k Keeper) WithdrawTokens(ctx sdk.Context, msg types.MsgWithdrawTokens) error {
+	if err := k.EnsureOwner(ctx, msg.CampaignId, msg.Sender); err != nil {
+		return err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.RecipientOrSender())
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range msg.Amount {
+		if err := k.EnsureCampaignDenom(ctx, msg.CampaignId, coin.Denom); err != nil {
+			return err
+		}
+
+		if err := k.EnsureUnallocated(ctx, msg.CampaignId, coin); err != nil {
+			return err
+		}
+
+		if msg.CampaignId != 0 {
+			campaign := k.GetCampaign(ctx, msg.CampaignId)
+			campaign.Balance = campaign.Balance.Sub(coin)
+			k.SetCampaign(ctx, *campaign)
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokens,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", msg.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.Coins(msg.Amount).String()),
+		),
+	)
+	return nil
+}
+
+// RevokeAllocation revokes the unclaimed amount of an allocation, releasing it from the reserved
+// amount of its campaign. Allocations without claims are deleted, claimed ones are capped at the
+// claimed amount. It returns the revoked amount.
+func (k Keeper) RevokeAllocation(ctx sdk.Context, sender string, campaignId uint64, address string) (sdk.Coin, error) {
+	allocation := k.GetAllocation(ctx, campaignId, address)
+	if allocation == nil {
+		return sdk.Coin{}, types.ErrAirdropAllocationDoesNotExists
+	}
+	if err := k.EnsureOwner(ctx, allocation.CampaignId, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	revoked := sdk.NewCoin(allocation.Amount.Denom, unclaimedAmount(*allocation))
+	if revoked.IsZero() {
+		return sdk.Coin{}, types.ErrAirdropAllocationAlreadyClaimed
+	}
+
+	if k.GetClaimRecord(ctx, campaignId, allocation.Address) == nil && (allocation.ClaimedAmount.IsNil() || allocation.ClaimedAmount.IsZero()) {
+		k.DeleteAllocation(ctx, campaignId, allocation.Address)
+	} else {
+		allocation.Amount = allocation.ClaimedAmount
+		k.SetAllocation(ctx, *allocation)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAllocation,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyAddress, allocation.Address),
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", allocation.CampaignId)),
+			sdk.NewAttribute(types.AttributeKeyAmount, revoked.String()),
+		),
+	)
+	return revoked, nil
+}
+
+// ResetReservedAmounts recomputes the reserved amounts of all campaigns from the stored
+// allocations and merkle airdrops.
+func (k Keeper) ResetReservedAmounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixReservedAmount)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, allocation := range k.GetAllAllocations(ctx) {
+		k.addReservedAmount(ctx, allocation.CampaignId, allocation.Amount.Denom, unclaimedAmount(allocation))
+	}
+	for _, airdrop := range k.GetAllMerkleAirdrops(ctx) {
+		k.addReservedAmount(ctx, airdrop.CampaignId, airdrop.TotalAmount.Denom, unclaimedMerkleAmount(airdrop))
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestRevokeAllocationAndWithdrawTokens() {
+	ctx := suite.ctx
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	partner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 1001)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, partner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}))
+	res, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+	campaignId := res.Id
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partner, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, campaignId))
+	suite.Require().NoError(err)
+	otherRes, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(other, "ufury", time.Time{}, time.Time{}, types.VestingParams{}))
+	suite.Require().NoError(err)
+
+	claimed, pubKey, sign := suite.cosmosClaimer()
+	setAllocation := func(sender sdk.AccAddress, address string, amount int64, campaignId uint64) error {
+		_, err := msgServer.SetAllocation(wctx, types.NewMsgSetAllocation(sender.String(), types.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       address,
+			Amount:        sdk.NewInt64Coin("ufury", amount),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+			CampaignId:    campaignId,
+		}))
+		return err
+	}
+	suite.Require().NoError(setAllocation(partner, claimed, 400, campaignId))
+	suite.Require().NoError(setAllocation(partner, "cosmos1unclaimed", 300, campaignId))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 700), suite.app.AirdropKeeper.GetReservedAmount(ctx, campaignId, "ufury"))
+
+	// allocations reserve the unallocated balance of their campaign
+	suite.Require().ErrorIs(setAllocation(partner, "cosmos1unfunded", 301, campaignId), types.ErrInsufficientUnallocatedBalance)
+	suite.Require().ErrorIs(setAllocation(partner, claimed, 701, campaignId), types.ErrInsufficientUnallocatedBalance)
+	suite.Require().NoError(setAllocation(partner, claimed, 400, campaignId))
+	suite.Require().ErrorIs(setAllocation(other, claimed, 1, otherRes.Id), types.ErrInsufficientUnallocatedBalance)
+
+	// another campaign allocating to the same address does not take over its allocation
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, other, sdk.Coins{sdk.NewInt64Coin("ufury", 1)}))
+	_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(other, sdk.Coins{sdk.NewInt64Coin("ufury", 1)}, otherRes.Id))
+	suite.Require().NoError(err)
+	suite.Require().NoError(setAllocation(other, claimed, 1, otherRes.Id))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, claimed).Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 1), suite.app.AirdropKeeper.GetAllocation(ctx, otherRes.Id, claimed).Amount)
+
+	// only the unallocated balance is withdrawn, by the campaign owner
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	_, err = msgServer.WithdrawTokens(wctx, types.NewMsgWithdrawTokens(other, campaignId, sdk.Coins{sdk.NewInt64Coin("ufury", 100)}, ""))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	_, err = msgServer.WithdrawTokens(wctx, types.NewMsgWithdrawTokens(partner, campaignId, sdk.Coins{sdk.NewInt64Coin("ufury", 400)}, recipient.String()))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnallocatedBalance)
+	_, err = msgServer.WithdrawTokens(wctx, types.NewMsgWithdrawTokens(partner, campaignId, sdk.Coins{sdk.NewInt64Coin("ufury", 100)}, recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 100), suite.app.BankKeeper.GetBalance(ctx, recipient, "ufury"))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 900), suite.app.AirdropKeeper.GetCampaign(ctx, campaignId).Balance)
+
+	// claims release their reservation along with the campaign balance
+	rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	err = suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+		Address:       claimed,
+		PubKey:        pubKey,
+		RewardAddress: rewardAddr,
+		Signature:     sign(rewardAddr, campaignId),
+		CampaignId:    campaignId,
+	})
+	suite.Require().NoError(err)
+	claimedAmount := suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, claimed).ClaimedAmount
+	suite.Require().True(claimedAmount.IsPositive())
+	unallocated, err := suite.app.AirdropKeeper.GetUnallocatedBalance(ctx, campaignId, "ufury")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), unallocated)
+
+	// revoking keeps the claimed amount of claimed allocations and deletes unclaimed ones
+	_, err = msgServer.RevokeAllocation(wctx, types.NewMsgRevokeAllocation(other, campaignId, claimed))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+	revokeRes, err := msgServer.RevokeAllocation(wctx, types.NewMsgRevokeAllocation(partner, campaignId, claimed))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400).Sub(claimedAmount), revokeRes.Amount)
+	suite.Require().Equal(claimedAmount, suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, claimed).Amount)
+	_, err = msgServer.RevokeAllocation(wctx, types.NewMsgRevokeAllocation(partner, campaignId, claimed))
+	suite.Require().ErrorIs(err, types.ErrAirdropAllocationAlreadyClaimed)
+
+	_, err = msgServer.RevokeAllocation(wctx, types.NewMsgRevokeAllocation(partner, campaignId, "cosmos1unclaimed"))
+	suite.Require().NoError(err)
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, "cosmos1unclaimed"))
+	suite.Require().True(suite.app.AirdropKeeper.GetReservedAmount(ctx, campaignId, "ufury").IsZero())
+
+	// merkle airdrops reserve their total amount
+	_, err = msgServer.CreateMerkleAirdrop(wctx, types.NewMsgCreateMerkleAirdrop(partner, "00", sdk.NewInt64Coin("ufury", 250), campaignId))
+	suite.Require().NoError(err)
+	unallocated, err = suite.app.AirdropKeeper.GetUnallocatedBalance(ctx, campaignId, "ufury")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.AirdropKeeper.GetCampaign(ctx, campaignId).Balance.SubAmount(sdk.NewInt(250)), unallocated)
+	_, err = msgServer.CreateMerkleAirdrop(wctx, types.NewMsgCreateMerkleAirdrop(partner, "00", unallocated.AddAmount(sdk.OneInt()), campaignId))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnallocatedBalance)
+
+	// the migration recomputes the same reserved amounts
+	reserved := suite.app.AirdropKeeper.GetReservedAmount(ctx, campaignId, "ufury")
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	suite.Require().Equal(reserved, suite.app.AirdropKeeper.GetReservedAmount(ctx, campaignId, "ufury"))
+
+	_, err = msgServer.WithdrawTokens(wctx, types.NewMsgWithdrawTokens(partner, campaignId, sdk.Coins{unallocated}, ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 250), suite.app.AirdropKeeper.GetCampaign(ctx, campaignId).Balance)
+}
@@ -1,157 +1,165 @@
 package keeper
 
 import (
-	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"sort"
 
-	appparams "github.com/furysport/fury-chain/app/params"
-	"github.com/furysport/fury-chain/x/airdrop/types"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
-	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
-	"github.com/ethereum/go-ethereum/accounts"
-	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/crypto"
-	solana "github.com/gagliardetto/solana-go"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+const (
+	// SignMessageVersion1 is the legacy sign message, only binding the claim to the reward address.
+	SignMessageVersion1 = 1
+	// SignMessageVersion2 binds the claim to the furya chain id and the campaign of the allocation.
+	SignMessageVersion2 = 2
 )
 
+// SignMessage is the legacy (v1) payload signed by claimers.
 type SignMessage struct {
 	Chain      string `json:"chain"`
 	Address    string `json:"address"`
 	RewardAddr string `json:"rewardAddr"`
 }
 
-func VerifySignature(chain string, address string, pubKey string, rewardAddr string, signatureBytes string) bool {
-	signMsg := SignMessage{
+// SignMessageV2 is the payload signed by claimers, a signature for one furya network
+// or campaign cannot be replayed on another.
+type SignMessageV2 struct {
+	Version    uint32 `json:"version"`
+	ChainId    string `json:"chainId"`
+	CampaignId uint64 `json:"campaignId"`
+	Chain      string `json:"chain"`
+	Address    string `json:"address"`
+	RewardAddr string `json:"rewardAddr"`
+}
+
+// SignatureVerifier proves that the owner of a native chain address authorized a claim to rewardAddr.
+// signBytes is the JSON encoded SignMessage the wallet was asked to sign. It returns nil for a valid
+// signature, otherwise ErrInvalidPubKey, ErrPubKeyAddressMismatch, ErrInvalidSignatureFormat or
+// ErrSignatureMismatch explaining why the claim is rejected.
+type SignatureVerifier func(address string, pubKey string, rewardAddr string, signature string, signBytes []byte) error
+
+var signatureVerifiers = map[string]SignatureVerifier{}
+
+// RegisterSignatureVerifier registers the verifier used for allocations of the given chain.
+// It panics if a verifier is already registered for the chain.
+func RegisterSignatureVerifier(chain string, verifier SignatureVerifier) {
+	if _, ok := signatureVerifiers[chain]; ok {
+		panic(fmt.Sprintf("signature verifier already registered for chain %s", chain))
+	}
+	signatureVerifiers[chain] = verifier
+}
+
+// GetSignatureVerifier returns the verifier registered for the chain, nil if the chain is unsupported.
+func GetSignatureVerifier(chain string) SignatureVerifier {
+	return signatureVerifiers[chain]
+}
+
+// SupportedChains returns the sorted list of chains with a registered verifier.
+func SupportedChains() []string {
+	chains := make([]string, 0, len(signatureVerifiers))
+	for chain := range signatureVerifiers {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+	return chains
+}
+
+func init() {
+	RegisterSignatureVerifier("solana", verifySolanaSignature)
+	RegisterSignatureVerifier("evm", verifyEvmSignature)
+	RegisterSignatureVerifier("terra", verifySecp256k1Signature("terra"))
+	RegisterSignatureVerifier("secret", verifyADR036Signature("secret"))
+	RegisterSignatureVerifier("stargaze", verifyADR036Signature("stars"))
+	RegisterSignatureVerifier("osmosis", verifyADR036Signature("osmo"))
+	RegisterSignatureVerifier("juno", verifyADR036Signature("juno"))
+	RegisterSignatureVerifier("cosmos", verifyADR036Signature("cosmos"))
+	RegisterSignatureVerifier("evmos", verifyADR036Signature("evmos"))
+	RegisterSignatureVerifier("injective", verifyADR036Signature("inj"))
+	RegisterSignatureVerifier("bitcoin", verifyBitcoinSignature)
+	RegisterSignatureVerifier("aptos", verifyAptosSignature)
+	RegisterSignatureVerifier("sui", verifySuiSignature)
+	RegisterSignatureVerifier(types.SnapshotChain, verifyADR036Signature("furya"))
+}
+
+// GetSignBytes returns the sign bytes of a claim on the furya chain chainId for an allocation of the campaign.
+func GetSignBytes(chainId string, campaignId uint64, chain string, address string, rewardAddr string) ([]byte, error) {
+	signMsg := SignMessageV2{
+		Version:    SignMessageVersion2,
+		ChainId:    chainId,
+		CampaignId: campaignId,
 		Chain:      chain,
 		Address:    address,
 		RewardAddr: rewardAddr,
 	}
-	signBytes, err := json.Marshal(signMsg)
-	if err != nil {
-		return false
+	return json.Marshal(signMsg)
+}
+
+// GetLegacySignBytes returns the sign bytes of the legacy sign message.
+func GetLegacySignBytes(chain string, address string, rewardAddr string) ([]byte, error) {
+	signMsg := SignMessage{
+		Chain:      chain,
+		Address:    address,
+		RewardAddr: rewardAddr,
 	}
-	keplrSignBytes := legacytx.StdSignBytes(
-		"", 0, 0, 0,
-		legacytx.StdFee{Amount: sdk.Coins{}, Gas: 0},
-		[]sdk.Msg{types.NewMsgSignData(address, signBytes)}, "",
-	)
-
-	switch chain {
-	case "solana":
-		pubkey, err := solana.PublicKeyFromBase58(address)
-		if err != nil {
-			return false
-		}
-		signatureData, err := hex.DecodeString(signatureBytes[2:])
-		if err != nil {
-			return false
-		}
-		signature := solana.SignatureFromBytes(signatureData)
-		return signature.Verify(pubkey, signBytes)
-	case "evm":
-		signatureData, err := hexutil.Decode(signatureBytes)
-		if err != nil {
-			return false
-		}
-		if len(signatureData) != crypto.SignatureLength {
-			return false
-		}
-		signatureData[crypto.RecoveryIDOffset] -= 27 // Transform yellow paper V from 27/28 to 0/1
-		recovered, err := crypto.SigToPub(accounts.TextHash(signBytes), signatureData)
-		if err != nil {
-			return false
-		}
-		recoveredAddr := crypto.PubkeyToAddress(*recovered)
-		return recoveredAddr.String() == address
-	case "terra":
-		pubKeyBytes, err := hexutil.Decode(pubKey)
-		if err != nil {
-			return false
-		}
-		secp256k1PubKey := secp256k1.PubKey{Key: pubKeyBytes}
-		terraAddr, err := bech32.ConvertAndEncode("terra", secp256k1PubKey.Address())
-		if err != nil {
-			return false
-		}
-		if terraAddr != address {
-			return false
-		}
+	return json.Marshal(signMsg)
+}
 
-		signatureData, err := hexutil.Decode(signatureBytes)
-		if err != nil {
-			return false
-		}
-		return secp256k1PubKey.VerifySignature(signBytes, signatureData)
-	case "secret":
-		pubKeyBytes, err := hexutil.Decode(pubKey)
-		if err != nil {
-			return false
-		}
-		secp256k1PubKey := secp256k1.PubKey{Key: pubKeyBytes}
-		secretAddr, err := bech32.ConvertAndEncode("secret", secp256k1PubKey.Address())
-		if err != nil {
-			return false
-		}
-		if secretAddr != address {
-			return false
-		}
+// VerifySignature verifies a claim signature over the given sign bytes with the verifier of the chain.
+func VerifySignature(chain string, address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	verifier := GetSignatureVerifier(chain)
+	if verifier == nil {
+		return sdkerrors.Wrap(types.ErrUnsupportedChain, chain)
+	}
+	return verifier(address, pubKey, rewardAddr, signatureBytes, signBytes)
+}
 
-		signatureData, err := hexutil.Decode(signatureBytes)
-		if err != nil {
-			return false
-		}
-		return secp256k1PubKey.VerifySignature(keplrSignBytes, signatureData)
-	case "stargaze":
-		_, bz, err := bech32.DecodeAndConvert(address)
-		if err != nil {
-			return false
-		}
+// VerifyClaimSignature verifies the signature of a claim of the allocation to rewardAddr, submitted with the
+// allocation address in the form given by the claimer. The signature must be made over the sign message bound
+// to the chain id and the allocation campaign, legacy sign messages are accepted until the legacy sign message
+// deadline.
+func (k Keeper) VerifyClaimSignature(ctx sdk.Context, allocation types.AirdropAllocation, address string, pubKey string, rewardAddr string, signature string) error {
+	acceptLegacy := ctx.BlockTime().Before(k.GetParamSet(ctx).LegacySignMessageDeadline)
+	return VerifyClaimSignBytes(ctx.ChainID(), allocation, address, pubKey, rewardAddr, signature, acceptLegacy)
+}
 
-		bech32Addr, err := bech32.ConvertAndEncode(appparams.Bech32PrefixAccAddr, bz)
-		if err != nil {
-			return false
-		}
+// VerifyClaimSignBytes verifies the signature of a claim of the allocation to rewardAddr on the furya chain chainId.
+// Wallets sign the address as the claimer gave it, so the sign message may contain either the normalized
+// allocation address or the submitted address. The error of the verification against the current sign message
+// of the normalized address is returned when all are rejected.
+func VerifyClaimSignBytes(chainId string, allocation types.AirdropAllocation, address string, pubKey string, rewardAddr string, signature string, acceptLegacy bool) error {
+	signedAddresses := []string{allocation.Address}
+	if address != "" && address != allocation.Address {
+		signedAddresses = append(signedAddresses, address)
+	}
 
-		return bech32Addr == rewardAddr
-	case "osmosis":
-		_, bz, err := bech32.DecodeAndConvert(address)
+	var firstErr error
+	for _, signedAddress := range signedAddresses {
+		signBytes, err := GetSignBytes(chainId, allocation.CampaignId, allocation.Chain, signedAddress, rewardAddr)
 		if err != nil {
-			return false
+			return err
 		}
-
-		bech32Addr, err := bech32.ConvertAndEncode(appparams.Bech32PrefixAccAddr, bz)
-		if err != nil {
-			return false
+		err = VerifySignature(allocation.Chain, allocation.Address, pubKey, rewardAddr, signature, signBytes)
+		if err == nil || !sdkerrors.IsOf(err, types.ErrSignatureMismatch) {
+			return err
 		}
-
-		return bech32Addr == rewardAddr
-	case "juno":
-		_, bz, err := bech32.DecodeAndConvert(address)
-		if err != nil {
-			return false
+		if firstErr == nil {
+			firstErr = err
 		}
 
-		bech32Addr, err := bech32.ConvertAndEncode(appparams.Bech32PrefixAccAddr, bz)
-		if err != nil {
-			return false
+		if !acceptLegacy {
+			continue
 		}
-
-		return bech32Addr == rewardAddr
-	case "cosmos":
-		_, bz, err := bech32.DecodeAndConvert(address)
+		legacySignBytes, err := GetLegacySignBytes(allocation.Chain, signedAddress, rewardAddr)
 		if err != nil {
-			return false
+			return err
 		}
-
-		bech32Addr, err := bech32.ConvertAndEncode(appparams.Bech32PrefixAccAddr, bz)
-		if err != nil {
-			return false
+		if VerifySignature(allocation.Chain, allocation.Address, pubKey, rewardAddr, signature, legacySignBytes) == nil {
+			return nil
 		}
-
-		return bech32Addr == rewardAddr
-	default: // unsupported chain
-		return false
 	}
+	return firstErr
 }
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/btcutil/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+const (
+	bitcoinMessageMagic     = "Bitcoin Signed Message:\n"
+	bitcoinP2PKHVersion     = 0x00
+	bitcoinP2SHVersion      = 0x05
+	bitcoinBech32Hrp        = "bc"
+	bitcoinCompactSigLength = 65
+)
+
+func bitcoinVarString(bz []byte) []byte {
+	buf := bytes.Buffer{}
+	n := len(bz)
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		_ = binary.Write(&buf, binary.LittleEndian, uint16(n))
+	default:
+		buf.WriteByte(0xfe)
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(n))
+	}
+	buf.Write(bz)
+	return buf.Bytes()
+}
+
+// BitcoinMessageHash returns the digest signed by bitcoin wallets for signmessage.
+func BitcoinMessageHash(message []byte) []byte {
+	data := append(bitcoinVarString([]byte(bitcoinMessageMagic)), bitcoinVarString(message)...)
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+func hash160(bz []byte) []byte {
+	sha := sha256.Sum256(bz)
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil)
+}
+
+// BitcoinAddresses returns the P2PKH, P2SH-P2WPKH and P2WPKH addresses of a serialized public key.
+// Segwit addresses are only returned for compressed public keys.
+func BitcoinAddresses(pubKey []byte) []string {
+	pubKeyHash := hash160(pubKey)
+	addrs := []string{base58.CheckEncode(pubKeyHash, bitcoinP2PKHVersion)}
+	if len(pubKey) != 33 {
+		return addrs
+	}
+
+	redeemScript := append([]byte{0x00, 0x14}, pubKeyHash...)
+	addrs = append(addrs, base58.CheckEncode(hash160(redeemScript), bitcoinP2SHVersion))
+
+	converted, err := bech32.ConvertBits(pubKeyHash, 8, 5, true)
+	if err != nil {
+		return addrs
+	}
+	segwitAddr, err := bech32.Encode(bitcoinBech32Hrp, append([]byte{0x00}, converted...))
+	if err != nil {
+		return addrs
+	}
+	return append(addrs, segwitAddr)
+}
+
+// verifyBitcoinSignature verifies a base64 compact signature produced by bitcoin signmessage
+// (BIP-137 headers) for P2PKH, P2SH-P2WPKH and P2WPKH addresses.
+func verifyBitcoinSignature(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	signatureData, err := base64.StdEncoding.DecodeString(signatureBytes)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+	if len(signatureData) != bitcoinCompactSigLength {
+		return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "expected %d bytes, got %d", bitcoinCompactSigLength, len(signatureData))
+	}
+
+	header := signatureData[0]
+	if header < 27 || header > 42 {
+		return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "invalid header %d", header)
+	}
+	compressed := header >= 31
+
+	// compact signatures are [V || R || S] whereas go-ethereum expects [R || S || V]
+	signature := append(append([]byte{}, signatureData[1:]...), (header-27)&3)
+	recovered, err := crypto.SigToPub(BitcoinMessageHash(signBytes), signature)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+
+	recoveredKey := crypto.FromECDSAPub(recovered)
+	if compressed {
+		recoveredKey = crypto.CompressPubkey(recovered)
+	}
+
+	for _, addr := range BitcoinAddresses(recoveredKey) {
+		if addr == address || (strings.HasPrefix(addr, bitcoinBech32Hrp+"1") && addr == strings.ToLower(address)) {
+			return nil
+		}
+	}
+	return types.ErrSignatureMismatch
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// ADR036SignBytes returns the amino sign doc signed by wallets through ADR-036 arbitrary signing
+// (e.g. Keplr signArbitrary).
+func ADR036SignBytes(signer string, data []byte) []byte {
+	return legacytx.StdSignBytes(
+		"", 0, 0, 0,
+		legacytx.StdFee{Amount: sdk.Coins{}, Gas: 0},
+		[]sdk.Msg{types.NewMsgSignData(signer, data)}, "",
+	)
+}
+
+// secp256k1PubKeyForAddress decodes a hex secp256k1 public key and checks it derives the bech32 address.
+func secp256k1PubKeyForAddress(prefix string, address string, pubKey string) (*secp256k1.PubKey, error) {
+	pubKeyBytes, err := hexutil.Decode(pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	if len(pubKeyBytes) != secp256k1.PubKeySize {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPubKey, "expected %d bytes, got %d", secp256k1.PubKeySize, len(pubKeyBytes))
+	}
+	secp256k1PubKey := secp256k1.PubKey{Key: pubKeyBytes}
+	bech32Addr, err := bech32.ConvertAndEncode(prefix, secp256k1PubKey.Address())
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	if bech32Addr != address {
+		return nil, sdkerrors.Wrapf(types.ErrPubKeyAddressMismatch, "public key derives %s", bech32Addr)
+	}
+	return &secp256k1PubKey, nil
+}
+
+// ethSecp256k1PubKeyForAddress decodes a hex ethsecp256k1 public key and checks it derives the bech32 address.
+func ethSecp256k1PubKeyForAddress(prefix string, address string, pubKey string) ([]byte, error) {
+	pubKeyBytes, err := hexutil.Decode(pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	ecdsaPubKey, err := crypto.DecompressPubkey(pubKeyBytes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	bech32Addr, err := bech32.ConvertAndEncode(prefix, crypto.PubkeyToAddress(*ecdsaPubKey).Bytes())
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	if bech32Addr != address {
+		return nil, sdkerrors.Wrapf(types.ErrPubKeyAddressMismatch, "public key derives %s", bech32Addr)
+	}
+	return pubKeyBytes, nil
+}
+
+// verifySecp256k1Signature verifies a secp256k1 signature over the raw sign bytes.
+func verifySecp256k1Signature(prefix string) SignatureVerifier {
+	return func(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+		secp256k1PubKey, err := secp256k1PubKeyForAddress(prefix, address, pubKey)
+		if err != nil {
+			return err
+		}
+
+		signatureData, err := hexutil.Decode(signatureBytes)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+		}
+		if !secp256k1PubKey.VerifySignature(signBytes, signatureData) {
+			return types.ErrSignatureMismatch
+		}
+		return nil
+	}
+}
+
+// verifyADR036Signature verifies an ADR-036 arbitrary signature of the sign bytes made with either
+// a secp256k1 key or an ethsecp256k1 key (e.g. evmos, injective), whichever derives the address.
+// The reward address is part of the sign bytes so claims can target any furya address.
+func verifyADR036Signature(prefix string) SignatureVerifier {
+	return func(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+		signatureData, err := hexutil.Decode(signatureBytes)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+		}
+		signDoc := ADR036SignBytes(address, signBytes)
+
+		secp256k1PubKey, err := secp256k1PubKeyForAddress(prefix, address, pubKey)
+		if err == nil {
+			if !secp256k1PubKey.VerifySignature(signDoc, signatureData) {
+				return types.ErrSignatureMismatch
+			}
+			return nil
+		}
+		if !sdkerrors.IsOf(err, types.ErrPubKeyAddressMismatch) {
+			return err
+		}
+
+		// the key does not derive the address as secp256k1, it may as ethsecp256k1
+		ethPubKey, ethErr := ethSecp256k1PubKeyForAddress(prefix, address, pubKey)
+		if ethErr != nil {
+			return err
+		}
+		if len(signatureData) != crypto.SignatureLength-1 {
+			return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "expected %d bytes, got %d", crypto.SignatureLength-1, len(signatureData))
+		}
+		if !crypto.VerifySignature(ethPubKey, crypto.Keccak256(signDoc), signatureData) {
+			return types.ErrSignatureMismatch
+		}
+		return nil
+	}
+}
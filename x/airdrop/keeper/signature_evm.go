@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+const (
+	EIP712DomainName      = "Furya Airdrop"
+	EIP712DomainVersion   = "1"
+	EIP712DomainVersionV2 = "2"
+)
+
+// GetEIP712TypedData returns the EIP-712 typed data wallets sign for an evm claim.
+// The domain carries no evm chain id so that the claim can be signed from any network,
+// the v2 claim carries the furya chain id and the campaign instead.
+func GetEIP712TypedData(signBytes []byte) (apitypes.TypedData, error) {
+	signMsg := SignMessageV2{}
+	if err := json.Unmarshal(signBytes, &signMsg); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Claim": {
+				{Name: "chain", Type: "string"},
+				{Name: "address", Type: "string"},
+				{Name: "rewardAddr", Type: "string"},
+			},
+		},
+		PrimaryType: "Claim",
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712DomainName,
+			Version: EIP712DomainVersion,
+		},
+		Message: apitypes.TypedDataMessage{
+			"chain":      signMsg.Chain,
+			"address":    signMsg.Address,
+			"rewardAddr": signMsg.RewardAddr,
+		},
+	}
+	switch signMsg.Version {
+	case 0, SignMessageVersion1:
+	case SignMessageVersion2:
+		typedData.Domain.Version = EIP712DomainVersionV2
+		typedData.Types["Claim"] = append(typedData.Types["Claim"],
+			apitypes.Type{Name: "chainId", Type: "string"},
+			apitypes.Type{Name: "campaignId", Type: "uint64"},
+		)
+		typedData.Message["chainId"] = signMsg.ChainId
+		typedData.Message["campaignId"] = fmt.Sprintf("%d", signMsg.CampaignId)
+	default:
+		return apitypes.TypedData{}, fmt.Errorf("unknown sign message version: %d", signMsg.Version)
+	}
+	return typedData, nil
+}
+
+// EIP712Hash returns the digest signed by eth_signTypedData_v4 for the typed data.
+func EIP712Hash(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+	rawData := append([]byte("\x19\x01"), append(domainSeparator, typedDataHash...)...)
+	return crypto.Keccak256(rawData), nil
+}
+
+func recoverEvmAddress(hash []byte, signatureData []byte) string {
+	signature := make([]byte, len(signatureData))
+	copy(signature, signatureData)
+	if signature[crypto.RecoveryIDOffset] >= 27 {
+		signature[crypto.RecoveryIDOffset] -= 27 // Transform yellow paper V from 27/28 to 0/1
+	}
+	recovered, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return ""
+	}
+	return crypto.PubkeyToAddress(*recovered).String()
+}
+
+// verifyEvmSignature accepts both personal_sign signatures of the sign bytes and
+// eth_signTypedData_v4 signatures of the claim typed data.
+func verifyEvmSignature(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	signatureData, err := hexutil.Decode(signatureBytes)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+	if len(signatureData) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "expected %d bytes, got %d", crypto.SignatureLength, len(signatureData))
+	}
+
+	if recoverEvmAddress(accounts.TextHash(signBytes), signatureData) == address {
+		return nil
+	}
+
+	typedData, err := GetEIP712TypedData(signBytes)
+	if err != nil {
+		return err
+	}
+	hash, err := EIP712Hash(typedData)
+	if err != nil {
+		return err
+	}
+	if recoverEvmAddress(hash, signatureData) != address {
+		return types.ErrSignatureMismatch
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+const (
+	aptosEd25519Scheme   = 0x00
+	suiEd25519Flag       = 0x00
+	suiPersonalMsgIntent = 0x03
+)
+
+// AptosAddress returns the account address of a single ed25519 public key.
+func AptosAddress(pubKey ed25519.PublicKey) string {
+	hash := sha3.Sum256(append(append([]byte{}, pubKey...), aptosEd25519Scheme))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// AptosFullMessage returns the message signed by aptos wallets through signMessage.
+// The reward address is used as nonce.
+func AptosFullMessage(signBytes []byte, rewardAddr string) []byte {
+	return []byte("APTOS\nmessage: " + string(signBytes) + "\nnonce: " + rewardAddr)
+}
+
+// SuiAddress returns the account address of an ed25519 public key.
+func SuiAddress(pubKey ed25519.PublicKey) string {
+	hash := blake2b.Sum256(append([]byte{suiEd25519Flag}, pubKey...))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// SuiPersonalMessageDigest returns the digest signed by sui wallets through signPersonalMessage.
+func SuiPersonalMessageDigest(message []byte) []byte {
+	// intent scope, version and app id followed by the bcs encoded vector<u8>
+	data := []byte{suiPersonalMsgIntent, 0x00, 0x00}
+	length := uint64(len(message))
+	for length >= 0x80 {
+		data = append(data, byte(length)|0x80)
+		length >>= 7
+	}
+	data = append(data, byte(length))
+	data = append(data, message...)
+
+	hash := blake2b.Sum256(data)
+	return hash[:]
+}
+
+func decodeEd25519PubKey(pubKey string) (ed25519.PublicKey, error) {
+	pubKeyBytes, err := hexutil.Decode(pubKey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	if len(pubKeyBytes) != ed25519.PublicKeySize {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPubKey, "expected %d bytes, got %d", ed25519.PublicKeySize, len(pubKeyBytes))
+	}
+	return pubKeyBytes, nil
+}
+
+func sameMoveAddress(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func verifyAptosSignature(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	edPubKey, err := decodeEd25519PubKey(pubKey)
+	if err != nil {
+		return err
+	}
+	if derived := AptosAddress(edPubKey); !sameMoveAddress(derived, address) {
+		return sdkerrors.Wrapf(types.ErrPubKeyAddressMismatch, "public key derives %s", derived)
+	}
+
+	signatureData, err := hexutil.Decode(signatureBytes)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+	if len(signatureData) != ed25519.SignatureSize {
+		return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "expected %d bytes, got %d", ed25519.SignatureSize, len(signatureData))
+	}
+	if !ed25519.Verify(edPubKey, AptosFullMessage(signBytes, rewardAddr), signatureData) {
+		return types.ErrSignatureMismatch
+	}
+	return nil
+}
+
+// verifySuiSignature accepts either a raw hex ed25519 signature with the hex public key,
+// or a hex serialized sui signature (flag || signature || public key).
+func verifySuiSignature(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	signatureData, err := hexutil.Decode(signatureBytes)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+
+	var edPubKey ed25519.PublicKey
+	switch len(signatureData) {
+	case ed25519.SignatureSize:
+		edPubKey, err = decodeEd25519PubKey(pubKey)
+		if err != nil {
+			return err
+		}
+	case 1 + ed25519.SignatureSize + ed25519.PublicKeySize:
+		if signatureData[0] != suiEd25519Flag {
+			return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "unsupported signature scheme flag %d", signatureData[0])
+		}
+		edPubKey = signatureData[1+ed25519.SignatureSize:]
+		signatureData = signatureData[1 : 1+ed25519.SignatureSize]
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidSignatureFormat, "unexpected length %d", len(signatureData))
+	}
+
+	if derived := SuiAddress(edPubKey); !sameMoveAddress(derived, address) {
+		return sdkerrors.Wrapf(types.ErrPubKeyAddressMismatch, "public key derives %s", derived)
+	}
+	if !ed25519.Verify(edPubKey, SuiPersonalMessageDigest(signBytes), signatureData) {
+		return types.ErrSignatureMismatch
+	}
+	return nil
+}
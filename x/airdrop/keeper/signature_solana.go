@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	solana "github.com/gagliardetto/solana-go"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func verifySolanaSignature(address string, pubKey string, rewardAddr string, signatureBytes string, signBytes []byte) error {
+	pubkey, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPubKey, err.Error())
+	}
+	if len(signatureBytes) < 2 {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, "empty signature")
+	}
+	signatureData, err := hex.DecodeString(signatureBytes[2:])
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidSignatureFormat, err.Error())
+	}
+	signature := solana.SignatureFromBytes(signatureData)
+	if !signature.Verify(pubkey, signBytes) {
+		return types.ErrSignatureMismatch
+	}
+	return nil
+}
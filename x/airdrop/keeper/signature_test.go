@@ -1,10 +1,194 @@
 package keeper_test
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
 )
 
+// TestVerifySignature checks synthetic known answer vectors. They are not captured from wallets, they are signed with
+// fixed test keys over the sign messages of furya-test-1 campaign 1 framed the way each wallet frames
+// them, so that any change to the sign message or to the framing of a scheme breaks them.
 func (suite *KeeperTestSuite) TestVerifySignature() {
+	rewardAddr := "furya1665x2fj8xyez0vqxs5pjhc6e7ktmmrx9qrt9m0"
+
+	// the v2 sign message and the ADR-036 sign doc are signed as is by wallets
+	signBytes, err := keeper.GetSignBytes("furya-test-1", 1, "cosmos", "cosmos1hla55js3mk86vr7dzaqk3prxaf5kc92f6um77w", rewardAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(`{"version":2,"chainId":"furya-test-1","campaignId":1,"chain":"cosmos","address":"cosmos1hla55js3mk86vr7dzaqk3prxaf5kc92f6um77w","rewardAddr":"furya1665x2fj8xyez0vqxs5pjhc6e7ktmmrx9qrt9m0"}`, string(signBytes))
+	suite.Require().Equal(
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"`+
+			base64.StdEncoding.EncodeToString(signBytes)+
+			`","signer":"cosmos1hla55js3mk86vr7dzaqk3prxaf5kc92f6um77w"}}],"sequence":"0"}`,
+		string(keeper.ADR036SignBytes("cosmos1hla55js3mk86vr7dzaqk3prxaf5kc92f6um77w", signBytes)),
+	)
+	suite.Require().Equal(
+		"APTOS\nmessage: "+string(signBytes)+"\nnonce: "+rewardAddr,
+		string(keeper.AptosFullMessage(signBytes, rewardAddr)),
+	)
+
+	tests := []struct {
+		testCase  string
+		chain     string
+		address   string
+		pubKey    string
+		signature string
+		legacy    bool
+		expectErr error
+	}{
+		{
+			"synthetic ADR-036 sign doc (keplr signArbitrary framing)",
+			"cosmos", "cosmos1hla55js3mk86vr7dzaqk3prxaf5kc92f6um77w",
+			"0x034096e91a90b77e5dfeff53c46fd6ef84957c4a72c183b78d9e18a5e598c87633",
+			"0x75ea00256eece8535286e5c1812a8d39cb4a5f6733c639aba9d61bd56a28c46f3b82a4d65e05513b82d45b5725879c44ac9349bdd4d633582b7c5a4e4d1ccbe3",
+			false, nil,
+		},
+		{
+			"synthetic EIP-191 personal message (metamask personal_sign framing)",
+			"evm", "0xfd125765C90467d865228ff635Dc402294E1B99c", "",
+			"0xb9747f60c1570bd2fd949f0b392457ccb99dfbf82b33b4be894e02876e0b32484a3a2ae188945244f3b058674204e391c731801d1d03dbe56189bfb53f7420ba1c",
+			false, nil,
+		},
+		{
+			"synthetic EIP-712 typed data (metamask eth_signTypedData_v4 framing)",
+			"evm", "0xfd125765C90467d865228ff635Dc402294E1B99c", "",
+			"0x79265ca1aa181abc20e631f9c99bb9edd9e0f25d6da3efb7c663015c471bff636067237f5cb4de4f26951eb00141ea58c18ab009b3d9cfe32f4a15cf5ca5d06d1c",
+			false, nil,
+		},
+		{
+			"synthetic EIP-191 personal message of the legacy sign message",
+			"evm", "0xfd125765C90467d865228ff635Dc402294E1B99c", "",
+			"0xfbc080012ec3c470176b61ea8c6930660d40aa59739112cc41f82987e959737d295c71e6f8b61493ee73cfad0752ade65988d67537c7ee43282b048082c367cb1c",
+			true, nil,
+		},
+		{
+			"synthetic EIP-191 personal message of another address",
+			"evm", "0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9", "",
+			"0xed41b43ee89d28652b34f0e5c769753ba3b4f0cb6b85bc63f29ba6680cedbf89688d66145b5326c1afb811117a196f0f14b73989b062dde980ce13cd06f1ad801b",
+			true, types.ErrSignatureMismatch,
+		},
+		{
+			"synthetic BIP-137 p2pkh compressed (electrum signmessage framing)",
+			"bitcoin", "1JW76b827gUxTP3CLYy4joFMAkFuDDi8GN", "",
+			"IB16hFe5oHlWAgXS32s1T+WQYQMmB/37nj3vhuXikrK8FO8oimMQTtGiFVjKUlJbaLiPAtam+dGDUO1MfWHQP2M=",
+			false, nil,
+		},
+		{
+			"synthetic aptos full message (petra signMessage framing)",
+			"aptos", "0xcc405722b15c00a19d37e51d9a756de1e61b780ad0935f3363bc7fce64edbdad",
+			"0xea4a6c63e29c520abef5507b132ec5f9954776aebebe7b92421eea691446d22c",
+			"0xf93a128ce0c4eac7fe89b9dbe8c1f487ee1451c5227adb64f7e03bc40c81dc52f2916fb477229b493c7b3f280892bdd92098e94bf440480d35668d35aa5e1d01",
+			false, nil,
+		},
+		{
+			"synthetic sui personal message (sui wallet signPersonalMessage framing)",
+			"sui", "0x3accd5a8a68a904952949b0ac6ce21ff3d78b4f5f6377cb5005af6a328331bfd", "",
+			"0x003efd38ff3a07548f57436653ffc3853aa2ae641038004f3ab05dcd275c40fe225279b97a80dbc3ddf0cf9289ff5d7214751ca0b4b517b153606d5190fc99a2021398f62c6d1a457c51ba6a4b5f3dbd2f69fca93216218dc8997e416bd17d93ca",
+			false, nil,
+		},
+		{
+			"synthetic solana message (phantom signMessage framing)",
+			"solana", "J2xccRtuG43drESLYznHhLhQkLTdfepcKYbiQ9BsJVaf", "",
+			"0xb9cd2ccd1dddf123e6f065b4a8a591ca91c9df7f989c36ea6fedbf422ea08491290e7233ee391b31957231929954031581bb821500a669614a762974dfe8d40a",
+			false, nil,
+		},
+	}
+
+	for _, tc := range tests {
+		signBytes, err := keeper.GetSignBytes("furya-test-1", 1, tc.chain, tc.address, rewardAddr)
+		if tc.legacy {
+			signBytes, err = keeper.GetLegacySignBytes(tc.chain, tc.address, rewardAddr)
+		}
+		suite.Require().NoError(err)
+		err = keeper.VerifySignature(tc.chain, tc.address, tc.pubKey, rewardAddr, tc.signature, signBytes)
+		if tc.expectErr == nil {
+			suite.Require().NoError(err, tc.testCase)
+		} else {
+			suite.Require().ErrorIs(err, tc.expectErr, tc.testCase)
+		}
+
+		// the vectors are bound to their sign message
+		if tc.expectErr == nil {
+			otherSignBytes, err := keeper.GetSignBytes("furya-test-1", 2, tc.chain, tc.address, rewardAddr)
+			suite.Require().NoError(err)
+			err = keeper.VerifySignature(tc.chain, tc.address, tc.pubKey, rewardAddr, tc.signature, otherSignBytes)
+			suite.Require().ErrorIs(err, types.ErrSignatureMismatch, tc.testCase)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSignatureVerifiers() {
+	rewardAddr := "furya1665x2fj8xyez0vqxs5pjhc6e7ktmmrx9qrt9m0"
+	otherRewardAddr := "furya1pkmvlnstq8q7djns3w882pcu92xh4c9xca6gk0"
+
+	secpPriv := secp256k1.GenPrivKeyFromSecret([]byte("airdrop signature vectors"))
+	secpPubKey := secpPriv.PubKey()
+	ethPriv, err := crypto.ToECDSA(secpPriv.Bytes())
+	suite.Require().NoError(err)
+	edPriv := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{0x42}, ed25519.SeedSize))
+	edPubKey := edPriv.Public().(ed25519.PublicKey)
+
+	signBytesFor := func(chain, address string) []byte {
+		bz, err := keeper.GetSignBytes(testChainId, 1, chain, address, rewardAddr)
+		suite.Require().NoError(err)
+		return bz
+	}
+	secpSign := func(msg []byte) string {
+		sig, err := secpPriv.Sign(msg)
+		suite.Require().NoError(err)
+		return hexutil.Encode(sig)
+	}
+	ethSign := func(hash []byte) string {
+		sig, err := crypto.Sign(hash, ethPriv)
+		suite.Require().NoError(err)
+		sig[crypto.RecoveryIDOffset] += 27
+		return hexutil.Encode(sig)
+	}
+	bitcoinSign := func(msg []byte, header byte) string {
+		sig, err := crypto.Sign(keeper.BitcoinMessageHash(msg), ethPriv)
+		suite.Require().NoError(err)
+		compact := append([]byte{header + sig[crypto.RecoveryIDOffset]}, sig[:crypto.RecoveryIDOffset]...)
+		return base64.StdEncoding.EncodeToString(compact)
+	}
+
+	evmAddr := crypto.PubkeyToAddress(ethPriv.PublicKey).String()
+	eip712Data, err := keeper.GetEIP712TypedData(signBytesFor("evm", evmAddr))
+	suite.Require().NoError(err)
+	eip712Hash, err := keeper.EIP712Hash(eip712Data)
+	suite.Require().NoError(err)
+
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", secpPubKey.Address())
+	suite.Require().NoError(err)
+	secretAddr, err := bech32.ConvertAndEncode("secret", secpPubKey.Address())
+	suite.Require().NoError(err)
+	sameKeyRewardAddr, err := bech32.ConvertAndEncode("furya", secpPubKey.Address())
+	suite.Require().NoError(err)
+	evmosAddr, err := bech32.ConvertAndEncode("evmos", crypto.PubkeyToAddress(ethPriv.PublicKey).Bytes())
+	suite.Require().NoError(err)
+	evmosSig, err := crypto.Sign(crypto.Keccak256(keeper.ADR036SignBytes(evmosAddr, signBytesFor("evmos", evmosAddr))), ethPriv)
+	suite.Require().NoError(err)
+
+	btcAddrs := keeper.BitcoinAddresses(secpPubKey.Bytes())
+	suite.Require().Len(btcAddrs, 3)
+	btcLegacyAddrs := keeper.BitcoinAddresses(crypto.FromECDSAPub(&ethPriv.PublicKey))
+	suite.Require().Len(btcLegacyAddrs, 1)
+
+	aptosAddr := keeper.AptosAddress(edPubKey)
+	suiAddr := keeper.SuiAddress(edPubKey)
+	suiSig := ed25519.Sign(edPriv, keeper.SuiPersonalMessageDigest(signBytesFor("sui", suiAddr)))
+	suiSerializedSig := append(append([]byte{0x00}, suiSig...), edPubKey...)
+
 	tests := []struct {
 		testCase   string
 		chain      string
@@ -12,34 +196,231 @@ func (suite *KeeperTestSuite) TestVerifySignature() {
 		pubKey     string
 		rewardAddr string
 		signature  string
-		expectPass bool
+		expectErr  error
 	}{
 		{
-			"evm invalid signature verification",
-			"evm",
-			"0x7fc66500c84a76ad7e9c93437bfc5ac33e2ddae9",
-			"",
-			"furya1665x2fj8xyez0vqxs5pjhc6e7ktmmrx9qrt9m0",
-			"0xed41b43ee89d28652b34f0e5c769753ba3b4f0cb6b85bc63f29ba6680cedbf89688d66145b5326c1afb811117a196f0f14b73989b062dde980ce13cd06f1ad801b",
-			false,
+			"evm personal sign",
+			"evm", evmAddr, "", rewardAddr,
+			ethSign(accounts.TextHash(signBytesFor("evm", evmAddr))),
+			nil,
+		},
+		{
+			"evm eip712 typed data",
+			"evm", evmAddr, "", rewardAddr,
+			ethSign(eip712Hash),
+			nil,
+		},
+		{
+			"evm truncated signature",
+			"evm", evmAddr, "", rewardAddr,
+			ethSign(accounts.TextHash(signBytesFor("evm", evmAddr)))[:66],
+			types.ErrInvalidSignatureFormat,
 		},
 		{
-			"evm successful verification",
-			"evm",
-			"0x583e8DD54b7C3F5Ea23862E0E852f0e6914475D5",
+			"evm eip712 typed data for another reward address",
+			"evm", evmAddr, "", otherRewardAddr,
+			ethSign(eip712Hash),
+			types.ErrSignatureMismatch,
+		},
+		{
+			"adr036 secret",
+			"secret", secretAddr, hexutil.Encode(secpPubKey.Bytes()), rewardAddr,
+			secpSign(keeper.ADR036SignBytes(secretAddr, signBytesFor("secret", secretAddr))),
+			nil,
+		},
+		{
+			"adr036 osmosis to a reward address of another key",
+			"osmosis", osmoAddr, hexutil.Encode(secpPubKey.Bytes()), rewardAddr,
+			secpSign(keeper.ADR036SignBytes(osmoAddr, signBytesFor("osmosis", osmoAddr))),
+			nil,
+		},
+		{
+			"adr036 osmosis malformed public key",
+			"osmosis", osmoAddr, "0x1234", rewardAddr,
+			secpSign(keeper.ADR036SignBytes(osmoAddr, signBytesFor("osmosis", osmoAddr))),
+			types.ErrInvalidPubKey,
+		},
+		{
+			"adr036 osmosis signed for another chain",
+			"osmosis", osmoAddr, hexutil.Encode(secpPubKey.Bytes()), rewardAddr,
+			secpSign(keeper.ADR036SignBytes(osmoAddr, signBytesFor("juno", osmoAddr))),
+			types.ErrSignatureMismatch,
+		},
+		{
+			"adr036 osmosis without signature to the same key",
+			"osmosis", osmoAddr, hexutil.Encode(secpPubKey.Bytes()), sameKeyRewardAddr,
 			"",
-			"furya1pkmvlnstq8q7djns3w882pcu92xh4c9xca6gk0",
-			"0xf2cde652dbe26e73e508782d673850ac10880fafef4f7cd2599fd434736ef0ca2d8a2bd65c7f8b67abc1a837f95a23e3c34789dd0ac230cb9b04000641d62b521c",
-			true,
+			types.ErrInvalidSignatureFormat,
+		},
+		{
+			"adr036 evmos ethsecp256k1",
+			"evmos", evmosAddr, hexutil.Encode(secpPubKey.Bytes()), rewardAddr,
+			hexutil.Encode(evmosSig[:crypto.RecoveryIDOffset]),
+			nil,
+		},
+		{
+			"adr036 evmos ethsecp256k1 signature verified as secp256k1",
+			"cosmos", evmosAddr, hexutil.Encode(secpPubKey.Bytes()), rewardAddr,
+			hexutil.Encode(evmosSig[:crypto.RecoveryIDOffset]),
+			types.ErrPubKeyAddressMismatch,
+		},
+		{
+			"bitcoin p2pkh compressed",
+			"bitcoin", btcAddrs[0], "", rewardAddr,
+			bitcoinSign(signBytesFor("bitcoin", btcAddrs[0]), 31),
+			nil,
+		},
+		{
+			"bitcoin p2sh-p2wpkh",
+			"bitcoin", btcAddrs[1], "", rewardAddr,
+			bitcoinSign(signBytesFor("bitcoin", btcAddrs[1]), 35),
+			nil,
+		},
+		{
+			"bitcoin p2wpkh",
+			"bitcoin", btcAddrs[2], "", rewardAddr,
+			bitcoinSign(signBytesFor("bitcoin", btcAddrs[2]), 39),
+			nil,
+		},
+		{
+			"bitcoin p2pkh uncompressed",
+			"bitcoin", btcLegacyAddrs[0], "", rewardAddr,
+			bitcoinSign(signBytesFor("bitcoin", btcLegacyAddrs[0]), 27),
+			nil,
+		},
+		{
+			"bitcoin signature of another address",
+			"bitcoin", btcAddrs[2], "", rewardAddr,
+			bitcoinSign(signBytesFor("bitcoin", btcAddrs[0]), 39),
+			types.ErrSignatureMismatch,
+		},
+		{
+			"aptos sign message",
+			"aptos", aptosAddr, hexutil.Encode(edPubKey), rewardAddr,
+			hexutil.Encode(ed25519.Sign(edPriv, keeper.AptosFullMessage(signBytesFor("aptos", aptosAddr), rewardAddr))),
+			nil,
+		},
+		{
+			"aptos nonce mismatch",
+			"aptos", aptosAddr, hexutil.Encode(edPubKey), rewardAddr,
+			hexutil.Encode(ed25519.Sign(edPriv, keeper.AptosFullMessage(signBytesFor("aptos", aptosAddr), otherRewardAddr))),
+			types.ErrSignatureMismatch,
+		},
+		{
+			"sui personal message",
+			"sui", suiAddr, hexutil.Encode(edPubKey), rewardAddr,
+			hexutil.Encode(suiSig),
+			nil,
+		},
+		{
+			"sui serialized signature",
+			"sui", suiAddr, "", rewardAddr,
+			hexutil.Encode(suiSerializedSig),
+			nil,
+		},
+		{
+			"sui signature for aptos address",
+			"sui", aptosAddr, hexutil.Encode(edPubKey), rewardAddr,
+			hexutil.Encode(suiSig),
+			types.ErrPubKeyAddressMismatch,
+		},
+		{
+			"unsupported chain",
+			"unknown", evmAddr, "", rewardAddr,
+			ethSign(accounts.TextHash(signBytesFor("unknown", evmAddr))),
+			types.ErrUnsupportedChain,
 		},
 	}
 
 	for _, tc := range tests {
-		passed := keeper.VerifySignature(tc.chain, tc.address, tc.pubKey, tc.rewardAddr, tc.signature)
-		if tc.expectPass {
-			suite.Require().True(passed)
+		signBytes, err := keeper.GetSignBytes(testChainId, 1, tc.chain, tc.address, tc.rewardAddr)
+		suite.Require().NoError(err)
+		err = keeper.VerifySignature(tc.chain, tc.address, tc.pubKey, tc.rewardAddr, tc.signature, signBytes)
+		if tc.expectErr == nil {
+			suite.Require().NoError(err, tc.testCase)
 		} else {
-			suite.Require().False(passed)
+			suite.Require().ErrorIs(err, tc.expectErr, tc.testCase)
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisterSignatureVerifier() {
+	suite.Require().Contains(keeper.SupportedChains(), "bitcoin")
+	suite.Require().Panics(func() {
+		keeper.RegisterSignatureVerifier("evm", nil)
+	})
+
+	if keeper.GetSignatureVerifier("test-chain") == nil {
+		keeper.RegisterSignatureVerifier("test-chain", func(address, pubKey, rewardAddr, signature string, signBytes []byte) error {
+			if signature != "valid" {
+				return types.ErrSignatureMismatch
+			}
+			return nil
+		})
+	}
+	suite.Require().NoError(keeper.VerifySignature("test-chain", "addr", "", "reward", "valid", nil))
+	suite.Require().ErrorIs(keeper.VerifySignature("test-chain", "addr", "", "reward", "invalid", nil), types.ErrSignatureMismatch)
+}
+
+func (suite *KeeperTestSuite) TestClaimSignatureReplay() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("airdrop replay"))
+	address, err := bech32.ConvertAndEncode("cosmos", privKey.PubKey().Address())
+	suite.Require().NoError(err)
+	pubKey := hexutil.Encode(privKey.PubKey().Bytes())
+	rewardAddr := "furya1665x2fj8xyez0vqxs5pjhc6e7ktmmrx9qrt9m0"
+	allocation := types.AirdropAllocation{Chain: "cosmos", Address: address, CampaignId: 1}
+
+	sign := func(signBytes []byte, err error) string {
+		suite.Require().NoError(err)
+		signature, err := privKey.Sign(keeper.ADR036SignBytes(address, signBytes))
+		suite.Require().NoError(err)
+		return hexutil.Encode(signature)
+	}
+	verify := func(ctx sdk.Context, signature string) bool {
+		return suite.app.AirdropKeeper.VerifyClaimSignature(ctx, allocation, address, pubKey, rewardAddr, signature) == nil
+	}
+
+	suite.Require().True(verify(ctx, sign(keeper.GetSignBytes(testChainId, 1, "cosmos", address, rewardAddr))))
+	// signatures for another furya network or campaign are rejected
+	suite.Require().False(verify(ctx, sign(keeper.GetSignBytes("furya-1", 1, "cosmos", address, rewardAddr))))
+	suite.Require().False(verify(ctx, sign(keeper.GetSignBytes(testChainId, 2, "cosmos", address, rewardAddr))))
+
+	// legacy signatures are only accepted before the legacy sign message deadline
+	legacySignature := sign(keeper.GetLegacySignBytes("cosmos", address, rewardAddr))
+	suite.Require().Equal(types.DefaultLegacySignMessageDeadline, suite.app.AirdropKeeper.GetParamSet(ctx).LegacySignMessageDeadline)
+	suite.Require().True(verify(ctx, legacySignature))
+	suite.Require().False(verify(ctx.WithBlockTime(types.DefaultLegacySignMessageDeadline), legacySignature))
+
+	// chains upgraded without a deadline accept legacy signatures for a period after the migration
+	suite.deleteParams(types.KeyLegacySignMessageDeadline)
+	suite.Require().True(suite.app.AirdropKeeper.GetParamSet(ctx).LegacySignMessageDeadline.IsZero())
+	suite.Require().False(verify(ctx, legacySignature))
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	deadline := ctx.BlockTime().Add(types.LegacySignMessageUpgradePeriod)
+	suite.Require().Equal(deadline, suite.app.AirdropKeeper.GetParamSet(ctx).LegacySignMessageDeadline)
+	suite.Require().True(verify(ctx, legacySignature))
+	suite.Require().False(verify(ctx.WithBlockTime(deadline), legacySignature))
+}
+
+func (suite *KeeperTestSuite) TestMigrateFeelessClaimParams() {
+	ctx := suite.ctx
+	params := suite.app.AirdropKeeper.GetParamSet(ctx)
+	params.MaxFeelessClaimsPerBlock = 0
+	suite.app.AirdropKeeper.SetParamSet(ctx, params)
+
+	// the fee-less claim limits of upgraded chains read as zero, which disables them
+	suite.deleteParams(types.KeyFeelessClaimGasLimit)
+	suite.Require().Zero(suite.app.AirdropKeeper.GetParamSet(ctx).FeelessClaimGasLimit)
+
+	// the migration sets the missing limits to their defaults and keeps the ones set
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	migrated := suite.app.AirdropKeeper.GetParamSet(ctx)
+	suite.Require().Equal(types.DefaultParams().FeelessClaimGasLimit, migrated.FeelessClaimGasLimit)
+	suite.Require().Zero(migrated.MaxFeelessClaimsPerBlock)
+	suite.Require().Equal(params.LegacySignMessageDeadline, migrated.LegacySignMessageDeadline)
+
+	suite.deleteParams(types.KeyMaxFeelessClaimsPerBlock)
+	suite.Require().NoError(keeper.NewMigrator(suite.app.AirdropKeeper).Migrate1to2(ctx))
+	suite.Require().Equal(types.DefaultParams().MaxFeelessClaimsPerBlock, suite.app.AirdropKeeper.GetParamSet(ctx).MaxFeelessClaimsPerBlock)
+}
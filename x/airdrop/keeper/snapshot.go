@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (k Keeper) GetSnapshot(ctx sdk.Context, campaignId uint64) *types.Snapshot {
+	snapshot := types.Snapshot{}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSnapshotKey(campaignId))
+	if bz == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return &snapshot
+}
+
+func (k Keeper) SetSnapshot(ctx sdk.Context, snapshot types.Snapshot) {
+	bz := k.cdc.MustMarshal(&snapshot)
+	ctx.KVStore(k.storeKey).Set(types.GetSnapshotKey(snapshot.CampaignId), bz)
+}
+
+func (k Keeper) GetAllSnapshots(ctx sdk.Context) []types.Snapshot {
+	snapshots := []types.Snapshot{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSnapshot)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot := types.Snapshot{}
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// MaxSnapshotEntries bounds the number of delegations and balances a snapshot scans
+const MaxSnapshotEntries = 100000
+
+// SnapshotAccount is a furya account selected by a snapshot with its measured amount of the bond denom
+type SnapshotAccount struct {
+	Address string
+	Amount  sdk.Int
+}
+
+// TakeSnapshot measures the bond denom of furya accounts at the current height and returns the accounts
+// selected by the snapshot params, sorted by decreasing measured amount. It fails once it scanned more than
+// maxEntries delegations and balances.
+func (k Keeper) TakeSnapshot(ctx sdk.Context, params types.SnapshotParams, maxEntries int) ([]SnapshotAccount, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amounts := map[string]sdk.Int{}
+	add := func(address string, amount sdk.Int) {
+		if current, ok := amounts[address]; ok {
+			amount = amount.Add(current)
+		}
+		amounts[address] = amount
+	}
+
+	entries := 0
+	validators := map[string]stakingtypes.Validator{}
+	k.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		entries++
+		if entries > maxEntries {
+			return true
+		}
+		validator, ok := validators[delegation.ValidatorAddress]
+		if !ok {
+			validator, ok = k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+			if !ok {
+				return false
+			}
+			validators[delegation.ValidatorAddress] = validator
+		}
+		add(delegation.DelegatorAddress, validator.TokensFromShares(delegation.Shares).TruncateInt())
+		return false
+	})
+
+	if params.Type == types.SnapshotHolders && entries <= maxEntries {
+		k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+			entries++
+			if entries > maxEntries {
+				return true
+			}
+			if coin.Denom == bondDenom {
+				add(address.String(), coin.Amount)
+			}
+			return false
+		})
+	}
+	if entries > maxEntries {
+		return nil, sdkerrors.Wrapf(types.ErrSnapshotTooLarge, "more than %d delegations and balances", maxEntries)
+	}
+
+	excluded := map[string]bool{}
+	for _, address := range params.ExcludeAddresses {
+		excluded[address] = true
+	}
+
+	accounts := []SnapshotAccount{}
+	for address, amount := range amounts {
+		if excluded[address] || !amount.IsPositive() || amount.LT(params.MinAmount) {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			continue
+		}
+		if _, ok := k.acountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI); ok {
+			continue
+		}
+		accounts = append(accounts, SnapshotAccount{Address: address, Amount: amount})
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		if !accounts[i].Amount.Equal(accounts[j].Amount) {
+			return accounts[i].Amount.GT(accounts[j].Amount)
+		}
+		return accounts[i].Address < accounts[j].Address
+	})
+	if params.TopN != 0 && len(accounts) > int(params.TopN) {
+		accounts = accounts[:params.TopN]
+	}
+	return accounts, nil
+}
+
+// CreateSnapshotCampaign creates a campaign owned by owner with allocations to the furya accounts selected
+// by a snapshot taken at the current height, so that it can run from a governance proposal or an upgrade
+// handler. The total amount of the snapshot is moved from the unallocated balance of the module owner pool
+// to the campaign balance before the allocations are set.
+func (k Keeper) CreateSnapshotCampaign(ctx sdk.Context, owner string, startTime time.Time, endTime time.Time, vesting types.VestingParams, params types.SnapshotParams) (*types.Snapshot, error) {
+	if err := params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidSnapshotParams, err.Error())
+	}
+
+	total := params.TotalAmount
+	if err := k.EnsureUnallocated(ctx, 0, total); err != nil {
+		return nil, err
+	}
+
+	accounts, err := k.TakeSnapshot(ctx, params, MaxSnapshotEntries)
+	if err != nil {
+		return nil, err
+	}
+	weight := sdk.NewInt(int64(len(accounts)))
+	if params.Weighting == types.WeightingProportional {
+		weight = sdk.ZeroInt()
+		for _, account := range accounts {
+			weight = weight.Add(account.Amount)
+		}
+	}
+
+	campaignId := k.CreateCampaign(ctx, owner, total.Denom, startTime, endTime, vesting)
+	if err := k.FundCampaign(ctx, campaignId, sdk.Coins{total}); err != nil {
+		return nil, err
+	}
+	snapshot := types.Snapshot{
+		CampaignId: campaignId,
+		Height:     ctx.BlockHeight(),
+		Params:     params,
+		Allocated:  sdk.NewCoin(total.Denom, sdk.ZeroInt()),
+	}
+	for _, account := range accounts {
+		amount := total.Amount.Quo(weight)
+		if params.Weighting == types.WeightingProportional {
+			amount = total.Amount.Mul(account.Amount).Quo(weight)
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		allocation := types.AirdropAllocation{
+			Chain:         types.SnapshotChain,
+			Address:       account.Address,
+			Amount:        sdk.NewCoin(total.Denom, amount),
+			ClaimedAmount: sdk.NewCoin(total.Denom, sdk.ZeroInt()),
+			CampaignId:    campaignId,
+		}
+		if err := k.EnsureAllocationFunded(ctx, allocation); err != nil {
+			return nil, err
+		}
+		k.SetAllocation(ctx, allocation)
+		snapshot.Count++
+		snapshot.Allocated = snapshot.Allocated.AddAmount(amount)
+	}
+	k.SetSnapshot(ctx, snapshot)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSnapshot,
+			sdk.NewAttribute(types.AttributeKeyCampaignId, fmt.Sprintf("%d", campaignId)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", snapshot.Height)),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", snapshot.Count)),
+			sdk.NewAttribute(types.AttributeKeyAmount, snapshot.Allocated.String()),
+		),
+	)
+	return &snapshot, nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop"
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+	minttypes "github.com/furysport/fury-chain/x/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateSnapshotCampaign() {
+	ctx := suite.ctx
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	small := sdk.AccAddress(suite.createValidator(100))
+	medium := sdk.AccAddress(suite.createValidator(1000))
+	large := sdk.AccAddress(suite.createValidator(3000))
+	holder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.Coins{sdk.NewInt64Coin(bondDenom, 5000)}
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, holder, coins))
+
+	// snapshot campaigns are funded by the unallocated balance of the owner pool
+	stakers := types.SnapshotParams{
+		Type:        types.SnapshotStakers,
+		MinAmount:   sdk.NewInt(500),
+		Weighting:   types.WeightingProportional,
+		TotalAmount: sdk.NewInt64Coin("ufury", 400),
+	}
+	reserved := suite.app.AirdropKeeper.GetReservedAmount(ctx, 0, "ufury")
+	suite.fundModuleAccount(sdk.Coins{reserved.AddAmount(sdk.NewInt(399))})
+	_, err := suite.app.AirdropKeeper.CreateSnapshotCampaign(ctx, owner.String(), time.Time{}, time.Time{}, types.VestingParams{}, stakers)
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnallocatedBalance)
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 801)})
+
+	// stakers above the min amount share the total amount proportionally to their stake
+	cacheCtx, _ := ctx.CacheContext()
+	snapshot, err := suite.app.AirdropKeeper.CreateSnapshotCampaign(cacheCtx, owner.String(), time.Time{}, time.Time{}, types.VestingParams{}, stakers)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), snapshot.Count)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), snapshot.Allocated)
+	suite.Require().Equal(owner.String(), suite.app.AirdropKeeper.GetCampaign(cacheCtx, snapshot.CampaignId).Owner)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 100), suite.app.AirdropKeeper.GetAllocation(cacheCtx, snapshot.CampaignId, medium.String()).Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 300), suite.app.AirdropKeeper.GetAllocation(cacheCtx, snapshot.CampaignId, large.String()).Amount)
+	suite.Require().Equal(types.SnapshotChain, suite.app.AirdropKeeper.GetAllocation(cacheCtx, snapshot.CampaignId, large.String()).Chain)
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(cacheCtx, snapshot.CampaignId, small.String()))
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(cacheCtx, snapshot.CampaignId, holder.String()))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), suite.app.AirdropKeeper.GetReservedAmount(cacheCtx, snapshot.CampaignId, "ufury"))
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), suite.app.AirdropKeeper.GetCampaign(cacheCtx, snapshot.CampaignId).Balance)
+
+	// holders are measured by stake and balance, the top accounts share the total amount equally
+	wctx := sdk.WrapSDKContext(ctx)
+	msg := types.NewMsgCreateSnapshotCampaign(types.GovModuleAddress(), owner.String(), time.Time{}, time.Time{}, types.VestingParams{}, types.SnapshotParams{
+		Type:             types.SnapshotHolders,
+		MinAmount:        sdk.ZeroInt(),
+		TopN:             2,
+		Weighting:        types.WeightingEqual,
+		TotalAmount:      sdk.NewInt64Coin("ufury", 401),
+		ExcludeAddresses: []string{large.String()},
+	})
+	suite.Require().NoError(msg.ValidateBasic())
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+	sender := *msg
+	sender.Sender = owner.String()
+	_, err = msgServer.CreateSnapshotCampaign(wctx, &sender)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughPermission)
+
+	proposal, err := types.NewOwnerMsgsProposal("snapshot", "airdrop to furya holders", []sdk.Msg{msg})
+	suite.Require().NoError(err)
+	suite.Require().NoError(proposal.ValidateBasic())
+	suite.Require().NoError(airdrop.NewOwnerProposalHandler(suite.app.AirdropKeeper)(ctx, proposal))
+
+	campaigns := suite.app.AirdropKeeper.GetAllCampaigns(ctx)
+	campaignId := campaigns[len(campaigns)-1].Id
+	res, err := suite.app.AirdropKeeper.Snapshot(wctx, &types.QuerySnapshotRequest{CampaignId: campaignId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Snapshot.Count)
+	suite.Require().Equal(ctx.BlockHeight(), res.Snapshot.Height)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 400), res.Snapshot.Allocated)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 401), suite.app.AirdropKeeper.GetCampaign(ctx, campaignId).Balance)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, holder.String()).Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, medium.String()).Amount)
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, large.String()))
+	suite.Require().Nil(suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, small.String()))
+
+	// accounts allocated by another campaign are allocated again, under the new campaign
+	snapshot, err = suite.app.AirdropKeeper.CreateSnapshotCampaign(ctx, owner.String(), time.Time{}, time.Time{}, types.VestingParams{}, msg.Snapshot)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), snapshot.Count)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), suite.app.AirdropKeeper.GetAllocation(ctx, snapshot.CampaignId, holder.String()).Amount)
+	suite.Require().Equal(sdk.NewInt64Coin("ufury", 200), suite.app.AirdropKeeper.GetAllocation(ctx, campaignId, holder.String()).Amount)
+
+	_, err = suite.app.AirdropKeeper.CreateSnapshotCampaign(ctx, owner.String(), time.Time{}, time.Time{}, types.VestingParams{}, types.SnapshotParams{})
+	suite.Require().ErrorIs(err, types.ErrInvalidSnapshotParams)
+
+	// the snapshot scan is bounded
+	entries := 0
+	suite.app.BankKeeper.IterateAllBalances(ctx, func(sdk.AccAddress, sdk.Coin) bool {
+		entries++
+		return false
+	})
+	suite.app.StakingKeeper.IterateAllDelegations(ctx, func(stakingtypes.Delegation) bool {
+		entries++
+		return false
+	})
+	_, err = suite.app.AirdropKeeper.TakeSnapshot(ctx, msg.Snapshot, entries)
+	suite.Require().NoError(err)
+	_, err = suite.app.AirdropKeeper.TakeSnapshot(ctx, msg.Snapshot, entries-1)
+	suite.Require().ErrorIs(err, types.ErrSnapshotTooLarge)
+}
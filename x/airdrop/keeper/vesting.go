@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// GetCampaignVesting returns the vesting params of the campaign, the module owner pool never vests.
+func (k Keeper) GetCampaignVesting(ctx sdk.Context, campaignId uint64) types.VestingParams {
+	if campaignId == 0 {
+		return types.VestingParams{}
+	}
+	campaign := k.GetCampaign(ctx, campaignId)
+	if campaign == nil {
+		return types.VestingParams{}
+	}
+	return campaign.Vesting
+}
+
+// SendClaimedCoins sends coins claimed from a campaign to the address,
+// into a vesting account when the campaign vests its claims.
+func (k Keeper) SendClaimedCoins(ctx sdk.Context, campaignId uint64, addr sdk.AccAddress, coins sdk.Coins) error {
+	vesting := k.GetCampaignVesting(ctx, campaignId)
+	if vesting.Type != types.VestingNone {
+		if err := k.AddVestingGrant(ctx, addr, coins, vesting); err != nil {
+			return err
+		}
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// AddVestingGrant makes coins about to be received by the address vest following the vesting params
+// from the block time. Missing and base accounts are turned into a vesting account of the vesting type,
+// periodic vesting accounts get the periods of the coins merged into their schedule. A continuous schedule
+// cannot take coins vesting over another window without vesting or locking coins again, so continuous vesting
+// accounts only get the coins added when they vest over the same window.
+func (k Keeper) AddVestingGrant(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins, vesting types.VestingParams) error {
+	startTime := ctx.BlockTime().Unix()
+	endTime := startTime + int64(vesting.Duration.Seconds())
+
+	var baseAcc *authtypes.BaseAccount
+	switch acc := k.acountKeeper.GetAccount(ctx, addr).(type) {
+	case nil:
+		newAcc, ok := k.acountKeeper.NewAccountWithAddress(ctx, addr).(*authtypes.BaseAccount)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingAccount, "cannot create vesting account for %s", addr)
+		}
+		baseAcc = newAcc
+	case *authtypes.BaseAccount:
+		baseAcc = acc
+	case *vestingtypes.ContinuousVestingAccount:
+		if vesting.Type != types.VestingContinuous {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingAccount, "%s is a continuous vesting account", addr)
+		}
+		if acc.StartTime != startTime || acc.EndTime != endTime {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingAccount, "%s is a continuous vesting account with another schedule", addr)
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+		k.acountKeeper.SetAccount(ctx, acc)
+		return nil
+	case *vestingtypes.PeriodicVestingAccount:
+		if vesting.Type != types.VestingPeriodic {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingAccount, "%s is a periodic vesting account", addr)
+		}
+		addVestingPeriods(acc, startTime, vesting.Periods(coins))
+		acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+		k.acountKeeper.SetAccount(ctx, acc)
+		return nil
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidVestingAccount, "%s cannot vest claimed coins", addr)
+	}
+
+	switch vesting.Type {
+	case types.VestingContinuous:
+		k.acountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccount(baseAcc, coins, startTime, endTime))
+	case types.VestingPeriodic:
+		k.acountKeeper.SetAccount(ctx, vestingtypes.NewPeriodicVestingAccount(baseAcc, coins, startTime, vesting.Periods(coins)))
+	}
+	return nil
+}
+
+// addVestingPeriods merges the periods starting at startTime into the schedule of the account.
+func addVestingPeriods(acc *vestingtypes.PeriodicVestingAccount, startTime int64, periods vestingtypes.Periods) {
+	type event struct {
+		eventTime int64
+		amount    sdk.Coins
+	}
+
+	events := []event{}
+	eventTime := acc.StartTime
+	for _, period := range acc.VestingPeriods {
+		eventTime += period.Length
+		events = append(events, event{eventTime, period.Amount})
+	}
+	eventTime = startTime
+	for _, period := range periods {
+		eventTime += period.Length
+		events = append(events, event{eventTime, period.Amount})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].eventTime < events[j].eventTime })
+
+	if startTime < acc.StartTime {
+		acc.StartTime = startTime
+	}
+	merged := vestingtypes.Periods{}
+	eventTime = acc.StartTime
+	for _, e := range events {
+		if len(merged) > 0 && e.eventTime == eventTime {
+			merged[len(merged)-1].Amount = merged[len(merged)-1].Amount.Add(e.amount...)
+			continue
+		}
+		merged = append(merged, vestingtypes.Period{Length: e.eventTime - eventTime, Amount: e.amount})
+		eventTime = e.eventTime
+	}
+
+	acc.VestingPeriods = merged
+	if eventTime > acc.EndTime {
+		acc.EndTime = eventTime
+	}
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+func (suite *KeeperTestSuite) TestClaimIntoVestingAccount() {
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(suite.app.AirdropKeeper)
+
+	partner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	suite.fundModuleAccount(sdk.Coins{sdk.NewInt64Coin("ufury", 8000)})
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, partner, sdk.Coins{sdk.NewInt64Coin("ufury", 8000)}))
+
+	err := types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{
+		Type: types.VestingPeriodic,
+	}).ValidateBasic()
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingParams)
+
+	continuous, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{
+		Type:     types.VestingContinuous,
+		Duration: 1000 * time.Second,
+	}))
+	suite.Require().NoError(err)
+	periodic, err := msgServer.CreateCampaign(wctx, types.NewMsgCreateCampaign(partner, "ufury", time.Time{}, time.Time{}, types.VestingParams{
+		Type:       types.VestingPeriodic,
+		Duration:   1000 * time.Second,
+		NumPeriods: 4,
+	}))
+	suite.Require().NoError(err)
+	for _, campaignId := range []uint64{continuous.Id, periodic.Id} {
+		_, err = msgServer.DepositTokens(wctx, types.NewMsgDepositTokens(partner, sdk.Coins{sdk.NewInt64Coin("ufury", 4000)}, campaignId))
+		suite.Require().NoError(err)
+	}
+
+	claim := func(ctx sdk.Context, campaignId uint64) sdk.AccAddress {
+		rewardAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		address, pubKey, sign := suite.cosmosClaimer()
+		suite.app.AirdropKeeper.SetAllocation(ctx, types.AirdropAllocation{
+			Chain:         "cosmos",
+			Address:       address,
+			Amount:        sdk.NewInt64Coin("ufury", 4000),
+			ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+			CampaignId:    campaignId,
+		})
+		err := suite.app.AirdropKeeper.ClaimAllocation(ctx, types.MsgClaimAllocation{
+			Address:       address,
+			PubKey:        pubKey,
+			RewardAddress: rewardAddr.String(),
+			Signature:     sign(rewardAddr.String(), campaignId),
+			CampaignId:    campaignId,
+		})
+		suite.Require().NoError(err)
+		return rewardAddr
+	}
+
+	// claims of a continuous vesting campaign create a continuous vesting account
+	rewardAddr := claim(ctx, continuous.Id)
+	continuousAcc, ok := suite.app.AccountKeeper.GetAccount(ctx, rewardAddr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, continuousAcc.OriginalVesting)
+	suite.Require().Equal(int64(1000), continuousAcc.StartTime)
+	suite.Require().Equal(int64(2000), continuousAcc.EndTime)
+	suite.Require().Equal(int64(1000), suite.app.BankKeeper.GetBalance(ctx, rewardAddr, "ufury").Amount.Int64())
+	suite.Require().True(continuousAcc.GetVestedCoins(ctx.BlockTime()).IsZero())
+
+	// grants of the same block are added to the schedule
+	continuousVesting := suite.app.AirdropKeeper.GetCampaignVesting(ctx, continuous.Id)
+	err = suite.app.AirdropKeeper.AddVestingGrant(ctx, rewardAddr, sdk.Coins{sdk.NewInt64Coin("ufury", 500)}, continuousVesting)
+	suite.Require().NoError(err)
+	continuousAcc = suite.app.AccountKeeper.GetAccount(ctx, rewardAddr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 1500)}, continuousAcc.OriginalVesting)
+	suite.Require().Equal(int64(2000), continuousAcc.EndTime)
+
+	// later grants are rejected, they would lock vested coins again and vest part of the new coins immediately
+	laterCtx := ctx.WithBlockTime(time.Unix(1500, 0))
+	err = suite.app.AirdropKeeper.AddVestingGrant(laterCtx, rewardAddr, sdk.Coins{sdk.NewInt64Coin("ufury", 500)}, continuousVesting)
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingAccount)
+	continuousAcc = suite.app.AccountKeeper.GetAccount(ctx, rewardAddr).(*vestingtypes.ContinuousVestingAccount)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 1500)}, continuousAcc.OriginalVesting)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 750)}, continuousAcc.GetVestedCoins(laterCtx.BlockTime()))
+
+	// vesting accounts of another type are rejected
+	err = suite.app.AirdropKeeper.AddVestingGrant(laterCtx, rewardAddr, sdk.Coins{sdk.NewInt64Coin("ufury", 500)}, suite.app.AirdropKeeper.GetCampaignVesting(ctx, periodic.Id))
+	suite.Require().ErrorIs(err, types.ErrInvalidVestingAccount)
+
+	// claims of a periodic vesting campaign create a periodic vesting account
+	rewardAddr = claim(ctx, periodic.Id)
+	periodicAcc, ok := suite.app.AccountKeeper.GetAccount(ctx, rewardAddr).(*vestingtypes.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, periodicAcc.OriginalVesting)
+	suite.Require().Len(periodicAcc.VestingPeriods, 4)
+	suite.Require().Equal(int64(2000), periodicAcc.EndTime)
+
+	// later grants are merged into the periods
+	err = suite.app.AirdropKeeper.AddVestingGrant(laterCtx, rewardAddr, sdk.Coins{sdk.NewInt64Coin("ufury", 1000)}, suite.app.AirdropKeeper.GetCampaignVesting(ctx, periodic.Id))
+	suite.Require().NoError(err)
+	periodicAcc = suite.app.AccountKeeper.GetAccount(ctx, rewardAddr).(*vestingtypes.PeriodicVestingAccount)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 2000)}, periodicAcc.OriginalVesting)
+	suite.Require().Equal(int64(2500), periodicAcc.EndTime)
+	suite.Require().Len(periodicAcc.VestingPeriods, 6)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 1500)}, periodicAcc.GetVestedCoins(time.Unix(2000, 0)))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("ufury", 2000)}, periodicAcc.GetVestedCoins(time.Unix(2500, 0)))
+}
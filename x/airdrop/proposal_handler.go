@@ -0,0 +1,48 @@
+package airdrop
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/furysport/fury-chain/x/airdrop/keeper"
+	"github.com/furysport/fury-chain/x/airdrop/types"
+)
+
+// NewOwnerProposalHandler returns the handler of airdrop governance proposals, which execute
+// airdrop messages as the governance module account so that governance can own the module.
+func NewOwnerProposalHandler(k keeper.Keeper) govtypes.Handler {
+	handler := NewHandler(k)
+
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.OwnerMsgsProposal:
+			return handleOwnerMsgsProposal(ctx, handler, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleOwnerMsgsProposal(ctx sdk.Context, handler sdk.Handler, p *types.OwnerMsgsProposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := types.ValidateOwnerProposalMsg(msg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+		for _, event := range res.Events {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NormalizeAddress returns the canonical form of a native chain address, under which allocations and
+// claim records are stored and looked up. The address format identifies the chain type, so lookups
+// without a chain normalize the same way:
+//   - evm addresses are EIP-55 checksummed
+//   - bech32 addresses of cosmos chains and bitcoin segwit addresses are lowercased
+//   - other hex addresses, of aptos and sui, are lowercased
+//   - base58 addresses of solana and legacy bitcoin addresses are case-sensitive and kept as is
+func NormalizeAddress(address string) string {
+	address = strings.TrimSpace(address)
+	lower := strings.ToLower(address)
+	if strings.HasPrefix(lower, "0x") {
+		if common.IsHexAddress(address) {
+			return common.HexToAddress(address).Hex()
+		}
+		return lower
+	}
+	if _, _, err := bech32.DecodeAndConvert(lower); err == nil {
+		return lower
+	}
+	return address
+}
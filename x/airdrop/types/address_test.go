@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		// evm addresses are EIP-55 checksummed
+		{"0x9d967594cc61453afefd657313e5f05be7c6f88f", "0x9d967594Cc61453aFEfD657313e5F05be7c6F88F"},
+		{"0X9D967594CC61453AFEFD657313E5F05BE7C6F88F", "0x9d967594Cc61453aFEfD657313e5F05be7c6F88F"},
+		{" 0x9d967594Cc61453aFEfD657313e5F05be7c6F88F ", "0x9d967594Cc61453aFEfD657313e5F05be7c6F88F"},
+		// bech32 addresses are lowercased
+		{"SECRET1KAP5KVFAHHVWUFVSJ5X6DVYQXZTYJHSV8Y3DA7", "secret1kap5kvfahhvwufvsj5x6dvyqxztyjhsv8y3da7"},
+		{"secret1kap5kvfahhvwufvsj5x6dvyqxztyjhsv8y3da7", "secret1kap5kvfahhvwufvsj5x6dvyqxztyjhsv8y3da7"},
+		// other hex addresses are lowercased
+		{"0xA1B2c3d4e5f60718293a4b5c6d7e8f9012345678901234567890abcdefABCDEF", "0xa1b2c3d4e5f60718293a4b5c6d7e8f9012345678901234567890abcdefabcdef"},
+		// base58 addresses are case-sensitive
+		{"5NWFFsuPdS4ZvFjgy8UuyC6XqF6kAGCXHmUwXpYGSKt7", "5NWFFsuPdS4ZvFjgy8UuyC6XqF6kAGCXHmUwXpYGSKt7"},
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		// invalid bech32 addresses are kept as is
+		{"cosmos1Unclaimed", "cosmos1Unclaimed"},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, NormalizeAddress(tc.address), tc.address)
+	}
+}
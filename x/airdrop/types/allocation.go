@@ -0,0 +1,19 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate checks the allocation has a chain and an address, and claims at most its amount in its denom
+func (a AirdropAllocation) Validate() error {
+	if a.Chain == "" || a.Address == "" {
+		return fmt.Errorf("allocation without chain or address")
+	}
+	if a.Amount.IsNil() || !a.Amount.IsValid() {
+		return fmt.Errorf("invalid allocation amount of %s: %s", a.Address, a.Amount)
+	}
+	if a.ClaimedAmount.IsNil() || !a.ClaimedAmount.IsValid() || a.ClaimedAmount.Denom != a.Amount.Denom || a.Amount.IsLT(a.ClaimedAmount) {
+		return fmt.Errorf("invalid allocation claimed amount of %s: %s", a.Address, a.ClaimedAmount)
+	}
+	return nil
+}
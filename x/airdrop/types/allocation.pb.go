@@ -31,13 +31,15 @@ type AirdropAllocation struct {
 	Address       string                                  `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=claimed_amount,json=claimedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"claimed_amount"`
+	// campaign_id is the campaign funding the allocation, zero for the module owner pool.
+	CampaignId uint64 `protobuf:"varint,5,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *AirdropAllocation) Reset()         { *m = AirdropAllocation{} }
 func (m *AirdropAllocation) String() string { return proto.CompactTextString(m) }
 func (*AirdropAllocation) ProtoMessage()    {}
 func (*AirdropAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1e3c9fead94de4f, []int{0}
+	return fileDescriptor_2094555ab5bcc900, []int{0}
 }
 func (m *AirdropAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,34 +82,115 @@ func (m *AirdropAllocation) GetAddress() string {
 	return ""
 }
 
+func (m *AirdropAllocation) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// AllocationUpload tracks a bulk upload of the allocations of a campaign sent in batches.
+type AllocationUpload struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// checksum is the hex running checksum of the allocations uploaded so far.
+	Checksum string `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// count is the number of allocations uploaded so far.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// allocated is the amount of the allocations uploaded so far.
+	Allocated github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=allocated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"allocated"`
+	// total is the amount of all the allocations of the upload.
+	Total github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total"`
+}
+
+func (m *AllocationUpload) Reset()         { *m = AllocationUpload{} }
+func (m *AllocationUpload) String() string { return proto.CompactTextString(m) }
+func (*AllocationUpload) ProtoMessage()    {}
+func (*AllocationUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2094555ab5bcc900, []int{1}
+}
+func (m *AllocationUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllocationUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationUpload.Merge(m, src)
+}
+func (m *AllocationUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocationUpload proto.InternalMessageInfo
+
+func (m *AllocationUpload) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *AllocationUpload) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *AllocationUpload) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AirdropAllocation)(nil), "furya.airdrop.v1beta1.AirdropAllocation")
+	proto.RegisterType((*AllocationUpload)(nil), "furya.airdrop.v1beta1.AllocationUpload")
 }
 
 func init() {
-	proto.RegisterFile("furya/airdrop/v1beta1/allocation.proto", fileDescriptor_c1e3c9fead94de4f)
+	proto.RegisterFile("furya/airdrop/v1beta1/allocation.proto", fileDescriptor_2094555ab5bcc900)
 }
 
-var fileDescriptor_c1e3c9fead94de4f = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2c, 0x49, 0x2d, 0xca,
-	0x2c, 0xc9, 0x2f, 0xca, 0xd4, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcc, 0xc9, 0xc9, 0x4f, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x80, 0x29, 0xd5, 0x83, 0x2a, 0xd5, 0x83, 0x2a, 0x95,
-	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x24, 0x93, 0xf3,
-	0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0x21, 0x12, 0x10, 0x0e, 0x44, 0x4a, 0xe9, 0x09, 0x23, 0x97, 0xa0,
-	0x23, 0xc4, 0x10, 0x47, 0xb8, 0x35, 0x42, 0x22, 0x5c, 0xac, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51,
-	0x6a, 0x71, 0xb1, 0x04, 0x13, 0x58, 0x1c, 0xc6, 0x15, 0x72, 0xe7, 0x62, 0x4b, 0xcc, 0xcd, 0x2f,
-	0xcd, 0x2b, 0x91, 0x60, 0x06, 0x49, 0x38, 0xe9, 0x9f, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc,
-	0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xd4, 0x5a, 0x28, 0xa5, 0x5b,
-	0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x9c, 0x9f, 0x99, 0x17, 0x04, 0xd5,
-	0x2e, 0x14, 0xc6, 0xc5, 0x97, 0x9c, 0x93, 0x98, 0x99, 0x9b, 0x9a, 0x12, 0x0f, 0x35, 0x90, 0x85,
-	0x3c, 0x03, 0x79, 0xa1, 0xc6, 0x38, 0x82, 0x4d, 0x71, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0x23, 0x24, 0x13, 0x43, 0x5c, 0x83, 0x3c, 0x43, 0xfc, 0x83, 0x3c,
-	0xf5, 0x61, 0xe1, 0xab, 0x0b, 0xf6, 0xb8, 0x7e, 0x05, 0x3c, 0x4a, 0xc0, 0x36, 0x24, 0xb1, 0x81,
-	0xc3, 0xce, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xc4, 0x21, 0x86, 0xb3, 0x01, 0x00, 0x00,
+var fileDescriptor_2094555ab5bcc900 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xb1, 0x6e, 0xe2, 0x30,
+	0x18, 0xc7, 0x63, 0x8e, 0x70, 0x87, 0x4f, 0x77, 0xba, 0xb3, 0x38, 0x29, 0xc7, 0x10, 0x10, 0xc3,
+	0x1d, 0x0b, 0xb1, 0xd0, 0x3d, 0x01, 0x9c, 0xaa, 0x8a, 0xa1, 0x4b, 0xa4, 0x76, 0xe8, 0x82, 0x8c,
+	0x9d, 0x06, 0x8b, 0x24, 0x8e, 0x62, 0xa7, 0x2a, 0x0f, 0xd0, 0xbd, 0x8f, 0xc5, 0xc8, 0x58, 0x75,
+	0x40, 0x15, 0x3c, 0x46, 0x97, 0x0a, 0xdb, 0x84, 0x8a, 0x91, 0x29, 0xfe, 0xfb, 0xfb, 0x7f, 0xbf,
+	0x4f, 0xf9, 0xfc, 0x87, 0x7f, 0xee, 0xca, 0x62, 0x49, 0x30, 0xe1, 0x05, 0x2b, 0x44, 0x8e, 0xef,
+	0x87, 0xb3, 0x48, 0x91, 0x21, 0x26, 0x49, 0x22, 0x28, 0x51, 0x5c, 0x64, 0x41, 0x5e, 0x08, 0x25,
+	0xd0, 0x2f, 0xed, 0x0b, 0xac, 0x2f, 0xb0, 0xbe, 0x76, 0x2b, 0x16, 0xb1, 0xd0, 0x0e, 0xbc, 0x3f,
+	0x19, 0x73, 0xfb, 0x37, 0x15, 0x32, 0x15, 0x72, 0x6a, 0x0a, 0x46, 0x98, 0x52, 0xef, 0xb1, 0x06,
+	0x7f, 0x8e, 0x0c, 0x64, 0x54, 0xcd, 0x40, 0x2d, 0xe8, 0xd2, 0x39, 0xe1, 0x99, 0x07, 0xba, 0xa0,
+	0xdf, 0x0c, 0x8d, 0x40, 0x1e, 0xfc, 0x4c, 0x18, 0x2b, 0x22, 0x29, 0xbd, 0x9a, 0xbe, 0x3f, 0x48,
+	0x74, 0x09, 0x1b, 0x24, 0x15, 0x65, 0xa6, 0xbc, 0x4f, 0xfb, 0xc2, 0x18, 0xaf, 0x36, 0x1d, 0xe7,
+	0x65, 0xd3, 0xf9, 0x1b, 0x73, 0x35, 0x2f, 0x67, 0x01, 0x15, 0xa9, 0x1d, 0x6b, 0x3f, 0x03, 0xc9,
+	0x16, 0x58, 0x2d, 0xf3, 0x48, 0x06, 0xff, 0x05, 0xcf, 0x42, 0xdb, 0x8e, 0x6e, 0xe0, 0x77, 0x9a,
+	0x10, 0x9e, 0x46, 0x6c, 0x6a, 0x81, 0xf5, 0xf3, 0x80, 0xdf, 0x2c, 0x66, 0x64, 0xb8, 0x1d, 0xf8,
+	0x95, 0x92, 0x34, 0x27, 0x3c, 0xce, 0xa6, 0x9c, 0x79, 0x6e, 0x17, 0xf4, 0xeb, 0x21, 0x3c, 0x5c,
+	0x4d, 0x58, 0xef, 0x0d, 0xc0, 0x1f, 0xc7, 0x05, 0x5c, 0xe7, 0x89, 0x20, 0xec, 0xb4, 0x0b, 0x9c,
+	0x76, 0xa1, 0x36, 0xfc, 0x42, 0xe7, 0x11, 0x5d, 0xc8, 0x32, 0xb5, 0x2b, 0xa9, 0xb4, 0xde, 0x61,
+	0xb5, 0x92, 0x7a, 0x68, 0x04, 0xba, 0x82, 0x4d, 0xfb, 0x96, 0x11, 0x3b, 0xf7, 0xdf, 0x8e, 0x04,
+	0x74, 0x01, 0x5d, 0x25, 0x14, 0x49, 0x3c, 0xf7, 0x3c, 0x94, 0xe9, 0x1e, 0x4f, 0x56, 0x5b, 0x1f,
+	0xac, 0xb7, 0x3e, 0x78, 0xdd, 0xfa, 0xe0, 0x69, 0xe7, 0x3b, 0xeb, 0x9d, 0xef, 0x3c, 0xef, 0x7c,
+	0xe7, 0x16, 0x7f, 0x20, 0xed, 0x23, 0x27, 0x73, 0x51, 0x28, 0x7d, 0x1a, 0xe8, 0x50, 0xe0, 0x87,
+	0x2a, 0xab, 0x1a, 0x3b, 0x6b, 0xe8, 0x5c, 0xfd, 0x7b, 0x1f, 0x00, 0xab, 0x5c, 0x4f, 0xc7, 0xc9,
+	0x02, 0x00, 0x00,
 }
 
 func (m *AirdropAllocation) Marshal() (dAtA []byte, err error) {
@@ -130,6 +213,11 @@ func (m *AirdropAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintAllocation(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.ClaimedAmount.Size()
 		i -= size
@@ -167,6 +255,66 @@ func (m *AirdropAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllocationUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAllocation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Allocated.Size()
+		i -= size
+		if _, err := m.Allocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAllocation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Count != 0 {
+		i = encodeVarintAllocation(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintAllocation(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintAllocation(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAllocation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAllocation(v)
 	base := offset
@@ -196,6 +344,32 @@ func (m *AirdropAllocation) Size() (n int) {
 	n += 1 + l + sovAllocation(uint64(l))
 	l = m.ClaimedAmount.Size()
 	n += 1 + l + sovAllocation(uint64(l))
+	if m.CampaignId != 0 {
+		n += 1 + sovAllocation(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *AllocationUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovAllocation(uint64(m.CampaignId))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovAllocation(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAllocation(uint64(m.Count))
+	}
+	l = m.Allocated.Size()
+	n += 1 + l + sovAllocation(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovAllocation(uint64(l))
 	return n
 }
 
@@ -366,6 +540,213 @@ func (m *AirdropAllocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocationUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAllocation(dAtA[iNdEx:])
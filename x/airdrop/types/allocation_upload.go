@@ -0,0 +1,31 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllocationsChecksum returns the running checksum of an allocation upload after the allocations,
+// chaining the sha256 of the previous hex checksum with the length prefixed encoded allocations.
+func AllocationsChecksum(prevChecksum string, allocations []AirdropAllocation) (string, error) {
+	prev, err := hex.DecodeString(prevChecksum)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := prev
+	for _, allocation := range allocations {
+		bz, err := allocation.Marshal()
+		if err != nil {
+			return "", err
+		}
+		hasher := sha256.New()
+		hasher.Write(checksum)
+		hasher.Write(sdk.Uint64ToBigEndian(uint64(len(bz))))
+		hasher.Write(bz)
+		checksum = hasher.Sum(nil)
+	}
+	return hex.EncodeToString(checksum), nil
+}
@@ -0,0 +1,911 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/airdrop/v1beta1/campaign.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VestingType defines the vesting account claimed amounts are paid into.
+type VestingType int32
+
+const (
+	VestingNone       VestingType = 0
+	VestingContinuous VestingType = 1
+	VestingPeriodic   VestingType = 2
+)
+
+var VestingType_name = map[int32]string{
+	0: "VestingNone",
+	1: "VestingContinuous",
+	2: "VestingPeriodic",
+}
+
+var VestingType_value = map[string]int32{
+	"VestingNone":       0,
+	"VestingContinuous": 1,
+	"VestingPeriodic":   2,
+}
+
+func (x VestingType) String() string {
+	return proto.EnumName(VestingType_name, int32(x))
+}
+
+func (VestingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f1d2093dd320e5, []int{0}
+}
+
+// Campaign defines an airdrop run by its own owner with its own funds.
+type Campaign struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// balance is the funded amount not yet paid out to claimers.
+	Balance github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
+	// start_time and end_time bound the claim window, a zero end_time never ends.
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// vesting defines how the amounts claimed from the campaign vest.
+	Vesting VestingParams `protobuf:"bytes,7,opt,name=vesting,proto3" json:"vesting"`
+}
+
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f1d2093dd320e5, []int{0}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(m, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Campaign) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Campaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Campaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Campaign) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Campaign) GetVesting() VestingParams {
+	if m != nil {
+		return m.Vesting
+	}
+	return VestingParams{}
+}
+
+// VestingParams defines the vesting schedule of claimed amounts, starting at the claim time.
+type VestingParams struct {
+	Type VestingType `protobuf:"varint,1,opt,name=type,proto3,enum=furya.airdrop.v1beta1.VestingType" json:"type,omitempty"`
+	// duration is the time it takes for a claimed amount to fully vest.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// num_periods splits the duration into equal periodic vesting periods.
+	NumPeriods uint32 `protobuf:"varint,3,opt,name=num_periods,json=numPeriods,proto3" json:"num_periods,omitempty"`
+}
+
+func (m *VestingParams) Reset()         { *m = VestingParams{} }
+func (m *VestingParams) String() string { return proto.CompactTextString(m) }
+func (*VestingParams) ProtoMessage()    {}
+func (*VestingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f1d2093dd320e5, []int{1}
+}
+func (m *VestingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingParams.Merge(m, src)
+}
+func (m *VestingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingParams proto.InternalMessageInfo
+
+func (m *VestingParams) GetType() VestingType {
+	if m != nil {
+		return m.Type
+	}
+	return VestingNone
+}
+
+func (m *VestingParams) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *VestingParams) GetNumPeriods() uint32 {
+	if m != nil {
+		return m.NumPeriods
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("furya.airdrop.v1beta1.VestingType", VestingType_name, VestingType_value)
+	proto.RegisterType((*Campaign)(nil), "furya.airdrop.v1beta1.Campaign")
+	proto.RegisterType((*VestingParams)(nil), "furya.airdrop.v1beta1.VestingParams")
+}
+
+func init() {
+	proto.RegisterFile("furya/airdrop/v1beta1/campaign.proto", fileDescriptor_41f1d2093dd320e5)
+}
+
+var fileDescriptor_41f1d2093dd320e5 = []byte{
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0xb6, 0xb2, 0xb4, 0x49, 0x15, 0xda, 0x66, 0x5a, 0x0b, 0x9e, 0x0f, 0x8e, 0x09, 0x85, 0x85,
+	0xb1, 0x5a, 0x34, 0x83, 0x5d, 0x07, 0x49, 0x2f, 0xbd, 0x8c, 0x62, 0xca, 0x0e, 0xbb, 0x14, 0xd9,
+	0x56, 0x5d, 0xb1, 0x5a, 0x32, 0x96, 0xdc, 0x2d, 0xff, 0x60, 0x04, 0x06, 0x3d, 0xee, 0x92, 0xd3,
+	0x4e, 0xfb, 0x27, 0x3d, 0xf6, 0x38, 0x06, 0xeb, 0x46, 0xf2, 0x47, 0x86, 0x64, 0x3b, 0x64, 0xed,
+	0x18, 0xf4, 0x64, 0xbd, 0xef, 0x7d, 0xef, 0x7b, 0xfa, 0xde, 0x93, 0xe1, 0xde, 0x59, 0x91, 0x4f,
+	0x08, 0x26, 0x2c, 0x8f, 0x73, 0x91, 0xe1, 0xcb, 0x83, 0x90, 0x2a, 0x72, 0x80, 0x23, 0x92, 0x66,
+	0x84, 0x25, 0xdc, 0xcf, 0x72, 0xa1, 0x04, 0xda, 0x35, 0x2c, 0xbf, 0x62, 0xf9, 0x15, 0xcb, 0xd9,
+	0x49, 0x44, 0x22, 0x0c, 0x03, 0xeb, 0x53, 0x49, 0x76, 0xdc, 0x44, 0x88, 0xe4, 0x82, 0x62, 0x13,
+	0x85, 0xc5, 0x19, 0x8e, 0x8b, 0x9c, 0x28, 0x26, 0x2a, 0x31, 0xa7, 0x77, 0x37, 0xaf, 0x58, 0x4a,
+	0xa5, 0x22, 0x69, 0x56, 0x12, 0xfa, 0x3f, 0x1b, 0xb0, 0x3d, 0xae, 0x2e, 0x80, 0xb6, 0x60, 0x83,
+	0xc5, 0x36, 0xf0, 0xc0, 0xa0, 0x19, 0x34, 0x58, 0x8c, 0x76, 0xe0, 0x9a, 0xf8, 0xc0, 0x69, 0x6e,
+	0x37, 0x3c, 0x30, 0xd8, 0x08, 0xca, 0x40, 0xa3, 0x31, 0xe5, 0x22, 0xb5, 0x1f, 0x95, 0xa8, 0x09,
+	0xd0, 0x11, 0x6c, 0x85, 0xe4, 0x82, 0xf0, 0x88, 0xda, 0x4d, 0x8d, 0x8f, 0xf0, 0xf5, 0x6d, 0xcf,
+	0xfa, 0x71, 0xdb, 0x7b, 0x96, 0x30, 0x75, 0x5e, 0x84, 0x7e, 0x24, 0x52, 0x1c, 0x09, 0x99, 0x0a,
+	0x59, 0x7d, 0xf6, 0x65, 0xfc, 0x1e, 0xab, 0x49, 0x46, 0xa5, 0x3f, 0x16, 0x8c, 0x07, 0x75, 0x3d,
+	0x1a, 0x43, 0x28, 0x15, 0xc9, 0xd5, 0xa9, 0xbe, 0xac, 0xbd, 0xe6, 0x81, 0x41, 0x67, 0xe8, 0xf8,
+	0xa5, 0x13, 0xbf, 0x76, 0xe2, 0x9f, 0xd4, 0x4e, 0x46, 0x6d, 0xdd, 0xe9, 0xea, 0x57, 0x0f, 0x04,
+	0x1b, 0xa6, 0x4e, 0x67, 0xd0, 0x6b, 0xd8, 0xa6, 0x3c, 0x2e, 0x25, 0xd6, 0x1f, 0x20, 0xd1, 0xa2,
+	0x3c, 0x36, 0x02, 0x87, 0xb0, 0x75, 0x49, 0xa5, 0x62, 0x3c, 0xb1, 0x5b, 0xa6, 0x7e, 0xcf, 0xff,
+	0xe7, 0x66, 0xfc, 0xb7, 0x25, 0xeb, 0x98, 0xe4, 0x24, 0x95, 0xa3, 0xa6, 0x56, 0x0a, 0xea, 0xd2,
+	0xfe, 0x37, 0x00, 0x37, 0xff, 0x22, 0xa0, 0x57, 0xb0, 0xa9, 0x4d, 0x9b, 0x31, 0x6f, 0x0d, 0xfb,
+	0xff, 0x17, 0x3d, 0x99, 0x64, 0x34, 0x30, 0x7c, 0x6d, 0xa8, 0x5e, 0xae, 0xd9, 0x47, 0x67, 0xf8,
+	0xf4, 0x9e, 0xa1, 0xc3, 0x8a, 0x50, 0xfa, 0xf9, 0xa2, 0xfd, 0x2c, 0x8b, 0x50, 0x0f, 0x76, 0x78,
+	0x91, 0x9e, 0x66, 0x34, 0x67, 0x22, 0x96, 0x66, 0x7b, 0x9b, 0x01, 0xe4, 0x45, 0x7a, 0x5c, 0x22,
+	0xcf, 0x3f, 0x03, 0xd8, 0x59, 0xe9, 0x8b, 0xbc, 0x65, 0xf8, 0x46, 0x70, 0xda, 0xb5, 0x9c, 0xed,
+	0xe9, 0xcc, 0x5b, 0x85, 0xd0, 0x0b, 0xf8, 0xb8, 0x0a, 0xc7, 0x82, 0x2b, 0xc6, 0x0b, 0x51, 0xc8,
+	0x2e, 0x70, 0x76, 0xa7, 0x33, 0xef, 0x7e, 0x02, 0x0d, 0xe0, 0x76, 0x3d, 0x0a, 0xd3, 0x91, 0x45,
+	0xdd, 0x86, 0xf3, 0x64, 0x3a, 0xf3, 0xee, 0xc2, 0x4e, 0xf3, 0xd3, 0x57, 0xd7, 0x1a, 0x1d, 0x5d,
+	0xcf, 0x5d, 0x70, 0x33, 0x77, 0xc1, 0xef, 0xb9, 0x0b, 0xae, 0x16, 0xae, 0x75, 0xb3, 0x70, 0xad,
+	0xef, 0x0b, 0xd7, 0x7a, 0x87, 0x57, 0xde, 0x94, 0x9e, 0x9f, 0xcc, 0x44, 0xae, 0xcc, 0x69, 0x3f,
+	0x3a, 0x27, 0x8c, 0xe3, 0x8f, 0xcb, 0xbf, 0xcc, 0x3c, 0xb0, 0x70, 0xdd, 0x8c, 0xe8, 0xe5, 0x9f,
+	0x01, 0x00, 0xf6, 0x03, 0xf1, 0xd0, 0x83, 0x03, 0x00, 0x00,
+}
+
+func (m *Campaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Campaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCampaign(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCampaign(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintCampaign(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCampaign(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCampaign(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPeriods != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.NumPeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCampaign(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintCampaign(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCampaign(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCampaign(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Campaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCampaign(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCampaign(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovCampaign(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovCampaign(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovCampaign(uint64(l))
+	return n
+}
+
+func (m *VestingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovCampaign(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCampaign(uint64(l))
+	if m.NumPeriods != 0 {
+		n += 1 + sovCampaign(uint64(m.NumPeriods))
+	}
+	return n
+}
+
+func sovCampaign(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCampaign(x uint64) (n int) {
+	return sovCampaign(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Campaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Campaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Campaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= VestingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPeriods", wireType)
+			}
+			m.NumPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCampaign(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCampaign
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCampaign(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCampaign
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCampaign
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCampaign
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCampaign
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCampaign
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCampaign        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCampaign          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCampaign = fmt.Errorf("proto: unexpected end of group")
+)
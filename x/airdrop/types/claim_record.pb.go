@@ -0,0 +1,1249 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/airdrop/v1beta1/claim_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Action defines the on-chain actions that unlock airdrop tranches.
+type Action int32
+
+const (
+	ActionInitialClaim Action = 0
+	ActionDelegate     Action = 1
+	ActionVote         Action = 2
+	ActionIBCTransfer  Action = 3
+)
+
+var Action_name = map[int32]string{
+	0: "ActionInitialClaim",
+	1: "ActionDelegate",
+	2: "ActionVote",
+	3: "ActionIBCTransfer",
+}
+
+var Action_value = map[string]int32{
+	"ActionInitialClaim": 0,
+	"ActionDelegate":     1,
+	"ActionVote":         2,
+	"ActionIBCTransfer":  3,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6e22211384e4de65, []int{0}
+}
+
+// ClaimRecord tracks the actions completed for a claimed allocation.
+type ClaimRecord struct {
+	// address is the native chain address of the allocation.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// reward_address is the furya address receiving the tranches.
+	RewardAddress string `protobuf:"bytes,2,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// action_completed is indexed by Action.
+	ActionCompleted []bool `protobuf:"varint,3,rep,packed,name=action_completed,json=actionCompleted,proto3" json:"action_completed,omitempty"`
+	// validator_address is the validator the reward address stakes stake_fraction of its payouts with.
+	ValidatorAddress string                                 `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StakeFraction    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=stake_fraction,json=stakeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stake_fraction"`
+	// campaign_id is the campaign of the claimed allocation, zero for the module owner pool.
+	CampaignId uint64 `protobuf:"varint,6,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
+func (m *ClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ClaimRecord) ProtoMessage()    {}
+func (*ClaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e22211384e4de65, []int{0}
+}
+func (m *ClaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRecord.Merge(m, src)
+}
+func (m *ClaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRecord proto.InternalMessageInfo
+
+func (m *ClaimRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetActionCompleted() []bool {
+	if m != nil {
+		return m.ActionCompleted
+	}
+	return nil
+}
+
+func (m *ClaimRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// ClaimReceipt records a payout of a claimed allocation to a furya account.
+type ClaimReceipt struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chain string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// address is the native chain address of the allocation.
+	Address    string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CampaignId uint64 `protobuf:"varint,4,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// reward_address is the furya address that claimed the allocation.
+	RewardAddress string `protobuf:"bytes,5,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// recipient is the furya address the amount was sent to, the reward address or a claim destination.
+	Recipient string                                  `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Height    int64                                   `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Time      time.Time                               `protobuf:"bytes,9,opt,name=time,proto3,stdtime" json:"time"`
+	// tx_hash is the hash of the transaction of the payout, empty for payouts outside of transactions.
+	TxHash string `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *ClaimReceipt) Reset()         { *m = ClaimReceipt{} }
+func (m *ClaimReceipt) String() string { return proto.CompactTextString(m) }
+func (*ClaimReceipt) ProtoMessage()    {}
+func (*ClaimReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e22211384e4de65, []int{1}
+}
+func (m *ClaimReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimReceipt.Merge(m, src)
+}
+func (m *ClaimReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimReceipt proto.InternalMessageInfo
+
+func (m *ClaimReceipt) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ClaimReceipt) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ClaimReceipt) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClaimReceipt) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *ClaimReceipt) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *ClaimReceipt) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ClaimReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ClaimReceipt) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ClaimReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("furya.airdrop.v1beta1.Action", Action_name, Action_value)
+	proto.RegisterType((*ClaimRecord)(nil), "furya.airdrop.v1beta1.ClaimRecord")
+	proto.RegisterType((*ClaimReceipt)(nil), "furya.airdrop.v1beta1.ClaimReceipt")
+}
+
+func init() {
+	proto.RegisterFile("furya/airdrop/v1beta1/claim_record.proto", fileDescriptor_6e22211384e4de65)
+}
+
+var fileDescriptor_6e22211384e4de65 = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xb6, 0xeb, 0xd6, 0x77, 0xac, 0x74, 0xd6, 0x36, 0xa2, 0x08, 0xa5, 0xd1, 0x24,
+	0x46, 0xf9, 0xb3, 0x44, 0x83, 0x0b, 0xd7, 0xb5, 0x13, 0xb0, 0x6b, 0x34, 0x38, 0x70, 0xa9, 0xdc,
+	0xd8, 0x4d, 0xac, 0x25, 0x71, 0x64, 0xbb, 0x63, 0xfb, 0x06, 0xa8, 0xa7, 0x7d, 0x81, 0x72, 0xe1,
+	0x2b, 0xf0, 0x21, 0x76, 0xdc, 0x11, 0x71, 0x18, 0x68, 0xbb, 0xf0, 0x31, 0x50, 0x9c, 0x64, 0x8c,
+	0xb1, 0x03, 0xa7, 0xf8, 0x7d, 0xfc, 0xe4, 0x79, 0xad, 0x9f, 0x5f, 0x43, 0x7f, 0x32, 0x15, 0x27,
+	0xd8, 0xc3, 0x4c, 0x10, 0xc1, 0x33, 0xef, 0x68, 0x67, 0x4c, 0x15, 0xde, 0xf1, 0x82, 0x18, 0xb3,
+	0x64, 0x24, 0x68, 0xc0, 0x05, 0x71, 0x33, 0xc1, 0x15, 0x47, 0xeb, 0xda, 0xe9, 0x96, 0x4e, 0xb7,
+	0x74, 0x5a, 0x6b, 0x21, 0x0f, 0xb9, 0x76, 0x78, 0xf9, 0xaa, 0x30, 0x5b, 0xbd, 0x90, 0xf3, 0x30,
+	0xa6, 0x9e, 0xae, 0xc6, 0xd3, 0x89, 0xa7, 0x58, 0x42, 0xa5, 0xc2, 0x49, 0x56, 0x18, 0x36, 0x3f,
+	0xd7, 0x61, 0x79, 0x98, 0x37, 0xf1, 0x75, 0x0f, 0x64, 0xc2, 0x22, 0x26, 0x44, 0x50, 0x29, 0x4d,
+	0xc3, 0x31, 0xfa, 0x6d, 0xbf, 0x2a, 0xd1, 0x23, 0xe8, 0x08, 0xfa, 0x11, 0x0b, 0x32, 0xaa, 0x0c,
+	0x75, 0x6d, 0x58, 0x29, 0xd4, 0xdd, 0xd2, 0xf6, 0x04, 0xba, 0x38, 0x50, 0x8c, 0xa7, 0xa3, 0x80,
+	0x27, 0x59, 0x4c, 0x15, 0x25, 0x66, 0xc3, 0x69, 0xf4, 0x97, 0xfc, 0xfb, 0x85, 0x3e, 0xac, 0x64,
+	0xf4, 0x0c, 0x56, 0x8f, 0x70, 0xcc, 0x08, 0x56, 0x5c, 0x5c, 0x87, 0x36, 0x75, 0x68, 0xf7, 0x7a,
+	0xa3, 0xca, 0x7d, 0x07, 0x1d, 0xa9, 0xf0, 0x21, 0x1d, 0x4d, 0x44, 0x91, 0x63, 0x2e, 0xe4, 0xce,
+	0x81, 0x7b, 0x76, 0xd1, 0xab, 0x7d, 0xbf, 0xe8, 0x6d, 0x85, 0x4c, 0x45, 0xd3, 0xb1, 0x1b, 0xf0,
+	0xc4, 0x0b, 0xb8, 0x4c, 0xb8, 0x2c, 0x3f, 0xdb, 0x92, 0x1c, 0x7a, 0xea, 0x24, 0xa3, 0xd2, 0xdd,
+	0xa3, 0x81, 0xbf, 0xa2, 0x53, 0x5e, 0x97, 0x21, 0xa8, 0x07, 0xcb, 0x01, 0x4e, 0x32, 0xcc, 0xc2,
+	0x74, 0xc4, 0x88, 0xd9, 0x72, 0x8c, 0x7e, 0xd3, 0x87, 0x4a, 0xda, 0x27, 0x9b, 0xbf, 0xea, 0x70,
+	0xaf, 0x02, 0x44, 0x59, 0xa6, 0x50, 0x07, 0xea, 0x8c, 0x68, 0x38, 0x4d, 0xbf, 0xce, 0x08, 0x5a,
+	0x83, 0x85, 0x20, 0xc2, 0x2c, 0x2d, 0x71, 0x14, 0xc5, 0x4d, 0x8e, 0x8d, 0xbf, 0x39, 0xde, 0xea,
+	0xd8, 0xbc, 0xdd, 0xf1, 0x0e, 0xd0, 0x0b, 0x77, 0x81, 0x7e, 0x08, 0x6d, 0x41, 0x03, 0x96, 0x31,
+	0x9a, 0x2a, 0x7d, 0xee, 0xb6, 0xff, 0x47, 0x40, 0x6f, 0xa0, 0x85, 0x13, 0x3e, 0x4d, 0x95, 0xb9,
+	0xa8, 0x31, 0x79, 0x25, 0xa6, 0xc7, 0xff, 0x81, 0x69, 0xc8, 0x59, 0xea, 0x97, 0xbf, 0xa3, 0x0d,
+	0x68, 0x45, 0x94, 0x85, 0x91, 0x32, 0x97, 0x1c, 0xa3, 0xdf, 0xf0, 0xcb, 0x0a, 0xbd, 0x82, 0x66,
+	0x3e, 0x4b, 0x66, 0xdb, 0x31, 0xfa, 0xcb, 0x2f, 0x2c, 0xb7, 0x18, 0x34, 0xb7, 0x1a, 0x34, 0xf7,
+	0xa0, 0x1a, 0xb4, 0xc1, 0x52, 0xde, 0xfa, 0xf4, 0x47, 0xcf, 0xf0, 0xf5, 0x1f, 0xe8, 0x01, 0x2c,
+	0xaa, 0xe3, 0x51, 0x84, 0x65, 0x64, 0x82, 0x3e, 0x76, 0x4b, 0x1d, 0xbf, 0xc5, 0x32, 0x7a, 0xfa,
+	0xd5, 0x80, 0xd6, 0x6e, 0x71, 0x2d, 0x2e, 0xa0, 0x62, 0xb5, 0x9f, 0x32, 0xc5, 0x70, 0xac, 0x6f,
+	0xa0, 0x5b, 0xb3, 0x36, 0x66, 0x73, 0xe7, 0x8e, 0x1d, 0xb4, 0x05, 0x9d, 0x42, 0xdd, 0xa3, 0x31,
+	0x0d, 0xb1, 0xa2, 0x5d, 0xc3, 0x42, 0xb3, 0xb9, 0x73, 0x4b, 0x45, 0x36, 0x40, 0xa1, 0xbc, 0xe7,
+	0x8a, 0x76, 0xeb, 0x56, 0x67, 0x36, 0x77, 0x6e, 0x28, 0xe8, 0x39, 0xac, 0x96, 0xe9, 0x83, 0xe1,
+	0x81, 0xc0, 0xa9, 0x9c, 0x50, 0xd1, 0x6d, 0x58, 0xeb, 0xb3, 0xb9, 0xf3, 0xef, 0x86, 0xd5, 0xfc,
+	0xf4, 0xc5, 0xae, 0x0d, 0xf6, 0xcf, 0x2e, 0x6d, 0xe3, 0xfc, 0xd2, 0x36, 0x7e, 0x5e, 0xda, 0xc6,
+	0xe9, 0x95, 0x5d, 0x3b, 0xbf, 0xb2, 0x6b, 0xdf, 0xae, 0xec, 0xda, 0x07, 0xef, 0x06, 0xec, 0xfc,
+	0xd5, 0xca, 0x8c, 0x0b, 0xa5, 0x57, 0xdb, 0x7a, 0x4a, 0xbc, 0xe3, 0xeb, 0x07, 0xaf, 0xc9, 0x8f,
+	0x5b, 0x1a, 0xdf, 0xcb, 0xdf, 0x03, 0x00, 0xfc, 0xd3, 0xf0, 0x7c, 0x0e, 0x04, 0x00, 0x00,
+}
+
+func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.StakeFraction.Size()
+		i -= size
+		if _, err := m.StakeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaimRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActionCompleted) > 0 {
+		for iNdEx := len(m.ActionCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ActionCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.ActionCompleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClaimRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaimRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaimRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaimRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	if len(m.ActionCompleted) > 0 {
+		n += 1 + sovClaimRecord(uint64(len(m.ActionCompleted))) + len(m.ActionCompleted)*1
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = m.StakeFraction.Size()
+	n += 1 + l + sovClaimRecord(uint64(l))
+	if m.CampaignId != 0 {
+		n += 1 + sovClaimRecord(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *ClaimReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovClaimRecord(uint64(m.Id))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovClaimRecord(uint64(m.CampaignId))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaimRecord(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovClaimRecord(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovClaimRecord(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	return n
+}
+
+func sovClaimRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaimRecord(x uint64) (n int) {
+	return sovClaimRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaimRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionCompleted = append(m.ActionCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaimRecord
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaimRecord
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaimRecord
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionCompleted) == 0 {
+					m.ActionCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaimRecord
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionCompleted = append(m.ActionCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionCompleted", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaimRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaimRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClaimRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClaimRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClaimRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClaimRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClaimRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClaimRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClaimRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClaimRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,16 +6,45 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// RegisterLegacyAminoCodec registers the airdrop messages, whose amino JSON is signed in
+// SIGN_MODE_LEGACY_AMINO_JSON, e.g. by Ledger devices. The names are part of the sign bytes
+// and must not change.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimAllocation{}, "furya/airdrop/ClaimAllocation", nil)
+	cdc.RegisterConcrete(&MsgSetAllocation{}, "furya/airdrop/SetAllocation", nil)
+	cdc.RegisterConcrete(&MsgSetAllocations{}, "furya/airdrop/SetAllocations", nil)
+	cdc.RegisterConcrete(&MsgTransferModuleOwnership{}, "furya/airdrop/TransferModuleOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptModuleOwnership{}, "furya/airdrop/AcceptModuleOwnership", nil)
+	cdc.RegisterConcrete(&MsgDepositTokens{}, "furya/airdrop/DepositTokens", nil)
+	cdc.RegisterConcrete(&MsgCreateMerkleAirdrop{}, "furya/airdrop/CreateMerkleAirdrop", nil)
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, "furya/airdrop/CreateCampaign", nil)
+	cdc.RegisterConcrete(&MsgRevokeAllocation{}, "furya/airdrop/RevokeAllocation", nil)
+	cdc.RegisterConcrete(&MsgWithdrawTokens{}, "furya/airdrop/WithdrawTokens", nil)
+	cdc.RegisterConcrete(&MsgCreateSnapshotCampaign{}, "furya/airdrop/CreateSnapshotCampaign", nil)
 	cdc.RegisterConcrete(&MsgSignData{}, "sign/MsgSignData", nil)
+	cdc.RegisterConcrete(&OwnerMsgsProposal{}, "furya/airdrop/OwnerMsgsProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimAllocation{},
+		&MsgSetAllocation{},
+		&MsgSetAllocations{},
+		&MsgTransferModuleOwnership{},
+		&MsgAcceptModuleOwnership{},
+		&MsgDepositTokens{},
+		&MsgCreateMerkleAirdrop{},
+		&MsgCreateCampaign{},
+		&MsgRevokeAllocation{},
+		&MsgWithdrawTokens{},
+		&MsgCreateSnapshotCampaign{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&OwnerMsgsProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -24,11 +53,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 var (
 	amino = codec.NewLegacyAmino()
 
-	// ModuleCdc references the global x/staking module codec. Note, the codec should
+	// ModuleCdc references the global x/airdrop module codec. Note, the codec should
 	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
 	// still used for that purpose.
 	//
-	// The actual codec used for serialization should be provided to x/staking and
+	// The actual codec used for serialization should be provided to x/airdrop and
 	// defined at the application level.
 	ModuleCdc = codec.NewAminoCodec(amino)
 )
@@ -13,4 +13,36 @@ var (
 	ErrNativeChainAccountSigVerificationFailure = errors.Register(ModuleName, 5, "native chain account signature verification failure")
 	ErrEmptyAddress                             = errors.Register(ModuleName, 6, "empty address")
 	ErrNotEnoughPermission                      = errors.Register(ModuleName, 7, "not enough permission for the action")
+	ErrMerkleAirdropDoesNotExists               = errors.Register(ModuleName, 8, "merkle airdrop does not exists")
+	ErrInvalidMerkleProof                       = errors.Register(ModuleName, 9, "invalid merkle proof")
+	ErrMerkleLeafAlreadyClaimed                 = errors.Register(ModuleName, 10, "merkle airdrop leaf is already claimed")
+	ErrAirdropAllocationAlreadyExists           = errors.Register(ModuleName, 11, "airdrop allocation already exists for the address")
+	ErrInvalidMerkleRoot                        = errors.Register(ModuleName, 12, "invalid merkle root")
+	ErrCampaignDoesNotExists                    = errors.Register(ModuleName, 13, "campaign does not exists")
+	ErrCampaignNotActive                        = errors.Register(ModuleName, 14, "campaign claim window is not active")
+	ErrInsufficientCampaignBalance              = errors.Register(ModuleName, 15, "insufficient campaign balance")
+	ErrInvalidCampaignDenom                     = errors.Register(ModuleName, 16, "denom does not match campaign denom")
+	ErrInvalidCampaignWindow                    = errors.Register(ModuleName, 17, "campaign end time is before start time")
+	ErrInvalidClaimAmount                       = errors.Register(ModuleName, 18, "invalid claim amount")
+	ErrInsufficientClaimableAmount              = errors.Register(ModuleName, 19, "claim amount exceeds the claimable amount")
+	ErrInvalidClaimDestinations                 = errors.Register(ModuleName, 20, "claim destinations do not add up to the claim amount")
+	ErrInvalidDelegatePercentage                = errors.Register(ModuleName, 21, "delegate percentage must be between 0 and 1")
+	ErrInvalidDelegation                        = errors.Register(ModuleName, 22, "claimed denom is not the bond denom")
+	ErrMaxVotingPowerExceeded                   = errors.Register(ModuleName, 23, "delegation exceeds the max validator voting power")
+	ErrInvalidVestingAccount                    = errors.Register(ModuleName, 24, "account cannot receive vesting claimed coins")
+	ErrInvalidVestingParams                     = errors.Register(ModuleName, 25, "invalid campaign vesting params")
+	ErrFeelessClaimRateLimited                  = errors.Register(ModuleName, 26, "too many fee-less claim attempts for the address")
+	ErrFeelessClaimCapReached                   = errors.Register(ModuleName, 27, "fee-less claims cap of the block reached")
+	ErrInvalidAllocationsChecksum               = errors.Register(ModuleName, 28, "invalid allocations upload checksum")
+	ErrInvalidAllocationsTotal                  = errors.Register(ModuleName, 29, "invalid allocations upload total")
+	ErrInsufficientUnallocatedBalance           = errors.Register(ModuleName, 30, "amount exceeds the unallocated balance")
+	ErrNotPendingOwner                          = errors.Register(ModuleName, 31, "sender is not the pending module owner")
+	ErrInvalidProposalMsgs                      = errors.Register(ModuleName, 32, "invalid owner proposal messages")
+	ErrInvalidSnapshotParams                    = errors.Register(ModuleName, 33, "invalid snapshot params")
+	ErrUnsupportedChain                         = errors.Register(ModuleName, 34, "no signature verifier registered for the chain")
+	ErrInvalidPubKey                            = errors.Register(ModuleName, 35, "malformed public key")
+	ErrPubKeyAddressMismatch                    = errors.Register(ModuleName, 36, "public key does not derive the allocation address")
+	ErrInvalidSignatureFormat                   = errors.Register(ModuleName, 37, "malformed signature")
+	ErrSignatureMismatch                        = errors.Register(ModuleName, 38, "signature does not match the sign message of the allocation address")
+	ErrSnapshotTooLarge                         = errors.Register(ModuleName, 39, "snapshot scans too many entries")
 )
@@ -1,9 +1,34 @@
 package types
 
 const (
-	EventTypeClaimAllocation = "claim_allocation"
+	EventTypeClaimAllocation     = "claim_allocation"
+	EventTypeCompleteAction      = "complete_action"
+	EventTypeCreateMerkleAirdrop = "create_merkle_airdrop"
+	EventTypeCreateCampaign      = "create_campaign"
+	EventTypeDepositTokens       = "deposit_tokens"
+	EventTypeDelegateClaim       = "delegate_claim"
+	EventTypeSetAllocations      = "set_allocations"
+	EventTypeSetAllocation       = "set_allocation"
+	EventTypeRevokeAllocation    = "revoke_allocation"
+	EventTypeWithdrawTokens      = "withdraw_tokens"
+	EventTypeTransferOwnership   = "transfer_module_ownership"
+	EventTypeAcceptOwnership     = "accept_module_ownership"
+	EventTypeCreateSnapshot      = "create_snapshot_campaign"
 
 	AttributeKeyAddress       = "address"
 	AttributeKeyAmount        = "amount"
 	AttributeKeyRewardAddress = "reward_address"
+	AttributeKeyAction        = "action"
+	AttributeKeyAirdropId     = "airdrop_id"
+	AttributeKeyMerkleRoot    = "merkle_root"
+	AttributeKeyCampaignId    = "campaign_id"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyDenom         = "denom"
+	AttributeKeySender        = "sender"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyChecksum      = "checksum"
+	AttributeKeyCount         = "count"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyNewOwner      = "new_owner"
+	AttributeKeyHeight        = "height"
 )
@@ -1,53 +1,70 @@
 package types
 
-import "github.com/cosmos/cosmos-sdk/types"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 
-// DefaultGenesis returns the default Capability genesis state
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default airdrop genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Allocations: []AirdropAllocation{
-			{
-				Chain:         "evm",
-				Address:       "0x--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 6)),
-			},
-			{
-				Chain:         "solana",
-				Address:       "--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 10)),
-			},
-			{
-				Chain:         "terra",
-				Address:       "terra--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 10)),
-			},
-			{
-				Chain:         "cosmos",
-				Address:       "cosmos--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 10)),
-			},
-			{
-				Chain:         "juno",
-				Address:       "juno--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 10)),
-			},
-			{
-				Chain:         "osmosis",
-				Address:       "osmo--",
-				Amount:        types.NewCoin("ufury", types.NewIntWithDecimal(100, 6)),
-				ClaimedAmount: types.NewCoin("ufury", types.NewIntWithDecimal(0, 10)),
-			},
-		},
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for _, record := range gs.ClaimRecords {
+		if len(record.ActionCompleted) != len(Action_name) {
+			return fmt.Errorf("invalid number of actions on claim record for %s: %d", record.Address, len(record.ActionCompleted))
+		}
+		if !record.StakeFraction.IsNil() && (record.StakeFraction.IsNegative() || record.StakeFraction.GT(types.OneDec())) {
+			return fmt.Errorf("invalid stake fraction on claim record for %s: %s", record.Address, record.StakeFraction)
+		}
+	}
+	for _, airdrop := range gs.MerkleAirdrops {
+		root, err := hex.DecodeString(airdrop.MerkleRoot)
+		if err != nil || len(root) != sha256.Size {
+			return fmt.Errorf("invalid merkle root of merkle airdrop %d: %s", airdrop.Id, airdrop.MerkleRoot)
+		}
+	}
+	for _, campaign := range gs.Campaigns {
+		if campaign.Id == 0 {
+			return fmt.Errorf("campaign id should be positive")
+		}
+		if campaign.Balance.Denom != campaign.Denom {
+			return fmt.Errorf("invalid balance denom of campaign %d: %s", campaign.Id, campaign.Balance.Denom)
+		}
+		if err := campaign.Vesting.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting of campaign %d: %w", campaign.Id, err)
+		}
+	}
+	for _, upload := range gs.AllocationUploads {
+		if upload.Allocated.Denom != upload.Total.Denom || upload.Total.IsLT(upload.Allocated) {
+			return fmt.Errorf("invalid allocation upload of campaign %d: %s of %s", upload.CampaignId, upload.Allocated, upload.Total)
+		}
+	}
+	for _, snapshot := range gs.Snapshots {
+		if err := snapshot.Params.Validate(); err != nil {
+			return fmt.Errorf("invalid snapshot of campaign %d: %w", snapshot.CampaignId, err)
+		}
+	}
+	receiptIds := map[uint64]bool{}
+	for _, receipt := range gs.ClaimReceipts {
+		if receipt.Id == 0 || receiptIds[receipt.Id] {
+			return fmt.Errorf("invalid claim receipt id: %d", receipt.Id)
+		}
+		receiptIds[receipt.Id] = true
+		if _, err := types.AccAddressFromBech32(receipt.RewardAddress); err != nil {
+			return fmt.Errorf("invalid reward address of claim receipt %d: %w", receipt.Id, err)
+		}
+	}
+	if err := validateOwner(gs.PendingOwner); err != nil {
+		return fmt.Errorf("invalid pending owner: %w", err)
+	}
 	return nil
 }
@@ -29,15 +29,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
-	Params      Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Allocations []AirdropAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+	Params             Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Allocations        []AirdropAllocation `protobuf:"bytes,2,rep,name=allocations,proto3" json:"allocations"`
+	ClaimRecords       []ClaimRecord       `protobuf:"bytes,3,rep,name=claim_records,json=claimRecords,proto3" json:"claim_records"`
+	MerkleAirdrops     []MerkleAirdrop     `protobuf:"bytes,4,rep,name=merkle_airdrops,json=merkleAirdrops,proto3" json:"merkle_airdrops"`
+	MerkleClaimedWords []MerkleClaimedWord `protobuf:"bytes,5,rep,name=merkle_claimed_words,json=merkleClaimedWords,proto3" json:"merkle_claimed_words"`
+	Campaigns          []Campaign          `protobuf:"bytes,6,rep,name=campaigns,proto3" json:"campaigns"`
+	AllocationUploads  []AllocationUpload  `protobuf:"bytes,7,rep,name=allocation_uploads,json=allocationUploads,proto3" json:"allocation_uploads"`
+	// pending_owner is the proposed module owner waiting to accept the ownership.
+	PendingOwner  string         `protobuf:"bytes,8,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Snapshots     []Snapshot     `protobuf:"bytes,9,rep,name=snapshots,proto3" json:"snapshots"`
+	ClaimReceipts []ClaimReceipt `protobuf:"bytes,10,rep,name=claim_receipts,json=claimReceipts,proto3" json:"claim_receipts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_70ea57bcfeb0bccc, []int{0}
+	return fileDescriptor_24c2ec9169f12d15, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -80,36 +89,106 @@ func (m *GenesisState) GetAllocations() []AirdropAllocation {
 	return nil
 }
 
+func (m *GenesisState) GetClaimRecords() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleAirdrops() []MerkleAirdrop {
+	if m != nil {
+		return m.MerkleAirdrops
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleClaimedWords() []MerkleClaimedWord {
+	if m != nil {
+		return m.MerkleClaimedWords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllocationUploads() []AllocationUpload {
+	if m != nil {
+		return m.AllocationUploads
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+func (m *GenesisState) GetSnapshots() []Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimReceipts() []ClaimReceipt {
+	if m != nil {
+		return m.ClaimReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "furya.airdrop.v1beta1.GenesisState")
 }
 
 func init() {
-	proto.RegisterFile("furya/airdrop/v1beta1/genesis.proto", fileDescriptor_70ea57bcfeb0bccc)
+	proto.RegisterFile("furya/airdrop/v1beta1/genesis.proto", fileDescriptor_24c2ec9169f12d15)
 }
 
-var fileDescriptor_70ea57bcfeb0bccc = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
-	0x10, 0xc7, 0xb3, 0xdf, 0x27, 0x3d, 0xa4, 0x9e, 0x8a, 0x87, 0xd2, 0xc3, 0xb6, 0x0a, 0x4a, 0x45,
-	0xdc, 0xa5, 0xf1, 0x2e, 0xb4, 0x20, 0x52, 0x10, 0x94, 0xb4, 0x27, 0x6f, 0x9b, 0x74, 0xdd, 0x2e,
-	0x26, 0x99, 0xb0, 0xbb, 0x11, 0x7d, 0x0b, 0xdf, 0xc1, 0x97, 0xe9, 0xb1, 0x47, 0x4f, 0x22, 0xc9,
-	0x8b, 0x88, 0xc9, 0x26, 0x16, 0x31, 0xb7, 0x5d, 0xe6, 0x37, 0xbf, 0xf9, 0xcf, 0xb8, 0x27, 0x86,
-	0x2b, 0x69, 0x40, 0x49, 0xca, 0xa4, 0x5a, 0x29, 0x48, 0xe9, 0xd3, 0x24, 0xe0, 0x86, 0x4d, 0xa8,
-	0xe0, 0x09, 0xd7, 0x52, 0x93, 0x54, 0x81, 0x81, 0x5e, 0xbf, 0xe6, 0x88, 0xe5, 0x88, 0xe5, 0x06,
-	0x07, 0x02, 0x04, 0x94, 0x10, 0xfd, 0x7e, 0x55, 0xfc, 0x00, 0x87, 0xa0, 0x63, 0xd0, 0x34, 0x60,
-	0x9a, 0x37, 0xca, 0x10, 0x64, 0x62, 0xeb, 0x87, 0x4d, 0x3d, 0x79, 0xfc, 0x7b, 0xe4, 0x00, 0x0b,
-	0x00, 0x11, 0x71, 0x5a, 0xfe, 0x82, 0xec, 0x81, 0xae, 0x32, 0xc5, 0x8c, 0x84, 0x5a, 0x31, 0xfc,
-	0x5d, 0x37, 0x32, 0xe6, 0xda, 0xb0, 0x38, 0xb5, 0xc0, 0x69, 0xeb, 0x6e, 0x2c, 0x8a, 0x20, 0xdc,
-	0x75, 0x1d, 0xb7, 0xa2, 0x29, 0x53, 0x2c, 0xb6, 0x91, 0x8e, 0xde, 0x90, 0xbb, 0x7f, 0x5d, 0x85,
-	0x5c, 0x18, 0x66, 0x78, 0xef, 0xd2, 0xed, 0x54, 0x40, 0x1f, 0x8d, 0xd0, 0xb8, 0xeb, 0x8d, 0x48,
-	0xdb, 0x9d, 0xc8, 0x5d, 0xc9, 0xcd, 0xf6, 0x36, 0x1f, 0x43, 0xc7, 0xb7, 0x5d, 0xbd, 0x85, 0xdb,
-	0xfd, 0xc9, 0xa2, 0xfb, 0xff, 0x46, 0xff, 0xc7, 0x5d, 0xef, 0xac, 0x5d, 0x32, 0xad, 0xfe, 0xd3,
-	0xa6, 0xc7, 0xfa, 0x76, 0x2d, 0xb3, 0x9b, 0x4d, 0x8e, 0xd1, 0x36, 0xc7, 0xe8, 0x33, 0xc7, 0xe8,
-	0xb5, 0xc0, 0xce, 0xb6, 0xc0, 0xce, 0x7b, 0x81, 0x9d, 0x7b, 0x4f, 0x48, 0xb3, 0xce, 0x02, 0x12,
-	0x42, 0x4c, 0x97, 0x57, 0xfe, 0x7c, 0x79, 0xeb, 0xcf, 0x69, 0x3d, 0xec, 0x3c, 0x5c, 0x33, 0x99,
-	0xd0, 0xe7, 0xe6, 0x04, 0xe6, 0x25, 0xe5, 0x3a, 0xe8, 0x94, 0xab, 0x5f, 0x7c, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0xeb, 0x0a, 0xad, 0x45, 0x2a, 0x02, 0x00, 0x00,
+var fileDescriptor_24c2ec9169f12d15 = []byte{
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0x1b, 0x36, 0x0a, 0x75, 0xdb, 0x21, 0xac, 0x21, 0x45, 0x95, 0x48, 0x4b, 0x3b, 0x41,
+	0x2f, 0x24, 0xda, 0x38, 0x72, 0xda, 0x7a, 0x40, 0x1c, 0x26, 0xaa, 0x56, 0x08, 0x09, 0x21, 0x15,
+	0x37, 0xf1, 0x52, 0x6b, 0x71, 0x6c, 0xf9, 0xb9, 0x8c, 0x5d, 0xf9, 0x05, 0xfc, 0xac, 0x1d, 0x77,
+	0xe4, 0x84, 0x50, 0xfb, 0x47, 0x50, 0x1c, 0x37, 0xad, 0xaa, 0xa6, 0xbb, 0x25, 0xef, 0x7d, 0xef,
+	0x8b, 0xdf, 0xcb, 0x33, 0xea, 0x5d, 0xcd, 0xd5, 0x2d, 0x09, 0x08, 0x53, 0x91, 0x12, 0x32, 0xf8,
+	0x71, 0x3a, 0xa5, 0x9a, 0x9c, 0x06, 0x31, 0x4d, 0x29, 0x30, 0xf0, 0xa5, 0x12, 0x5a, 0xe0, 0x17,
+	0x06, 0xf2, 0x2d, 0xe4, 0x5b, 0xa8, 0x75, 0x1c, 0x8b, 0x58, 0x18, 0x22, 0xc8, 0x9e, 0x72, 0xb8,
+	0xe5, 0x85, 0x02, 0xb8, 0x80, 0x60, 0x4a, 0x80, 0x16, 0xbe, 0x50, 0xb0, 0xd4, 0xe6, 0x5f, 0x15,
+	0xf9, 0xf4, 0x7a, 0xf7, 0xf7, 0x5a, 0x5e, 0x2c, 0x44, 0x9c, 0xd0, 0xc0, 0xbc, 0x4d, 0xe7, 0x57,
+	0x41, 0x34, 0x57, 0x44, 0x33, 0xb1, 0x52, 0xb4, 0xb7, 0xf3, 0x9a, 0x71, 0x0a, 0x9a, 0x70, 0x69,
+	0x81, 0xd7, 0xbb, 0xbb, 0x22, 0x49, 0x22, 0xc2, 0x4d, 0x51, 0x7f, 0x37, 0x17, 0x26, 0x84, 0xf1,
+	0x89, 0xa2, 0xa1, 0x50, 0x91, 0x25, 0xbb, 0xbb, 0x49, 0x4e, 0xd5, 0x75, 0x42, 0x2d, 0x73, 0x52,
+	0x62, 0x23, 0x5c, 0x12, 0x16, 0xa7, 0xfb, 0x4d, 0x92, 0x28, 0xc2, 0x61, 0xbf, 0x09, 0x52, 0x22,
+	0x61, 0x26, 0x74, 0x4e, 0x75, 0x7f, 0x55, 0x51, 0xe3, 0x43, 0x3e, 0xb8, 0xb1, 0x26, 0x9a, 0xe2,
+	0xf7, 0xa8, 0x9a, 0x6b, 0x5c, 0xa7, 0xe3, 0xf4, 0xeb, 0x67, 0x2f, 0xfd, 0x9d, 0x3f, 0xce, 0x1f,
+	0x1a, 0xe8, 0xe2, 0xf0, 0xee, 0x6f, 0xbb, 0x32, 0xb2, 0x25, 0x78, 0x88, 0xea, 0xeb, 0xf9, 0x80,
+	0xfb, 0xa8, 0x73, 0xd0, 0xaf, 0x9f, 0xf5, 0x4b, 0x0c, 0xe7, 0xf9, 0xfb, 0x79, 0x51, 0x60, 0x65,
+	0x9b, 0x0a, 0x7c, 0x89, 0x9a, 0x9b, 0x93, 0x04, 0xf7, 0xc0, 0x38, 0xbb, 0x25, 0xce, 0x41, 0xc6,
+	0x8e, 0x0c, 0x6a, 0x6d, 0x8d, 0x70, 0x1d, 0x02, 0x3c, 0x46, 0xcf, 0xf2, 0x71, 0x4f, 0x6c, 0x25,
+	0xb8, 0x87, 0x46, 0x78, 0x52, 0x22, 0xbc, 0x34, 0xb4, 0x3d, 0xaa, 0x55, 0x1e, 0xf1, 0xcd, 0x20,
+	0xe0, 0xef, 0xe8, 0xd8, 0x4a, 0xcd, 0xb7, 0x68, 0x34, 0xb9, 0x31, 0x47, 0x7d, 0xbc, 0xb7, 0xfd,
+	0xdc, 0x3c, 0xc8, 0x2b, 0xbe, 0xac, 0x0f, 0x8c, 0xf9, 0x76, 0x02, 0xf0, 0x00, 0xd5, 0x56, 0x1b,
+	0x00, 0x6e, 0xd5, 0x68, 0xdb, 0x65, 0x13, 0xb0, 0x9c, 0xb5, 0xad, 0xeb, 0xf0, 0x37, 0x84, 0xd7,
+	0x93, 0x9d, 0xcc, 0x65, 0x22, 0x48, 0x04, 0xee, 0x13, 0x63, 0x7b, 0x53, 0xf6, 0x8f, 0x8a, 0x82,
+	0xcf, 0x86, 0xb7, 0xd6, 0xe7, 0x64, 0x2b, 0x0e, 0xb8, 0x87, 0x9a, 0x92, 0xa6, 0x11, 0x4b, 0xe3,
+	0x89, 0xb8, 0x49, 0xa9, 0x72, 0x9f, 0x76, 0x9c, 0x7e, 0x6d, 0xd4, 0xb0, 0xc1, 0x4f, 0x59, 0x2c,
+	0xeb, 0x63, 0xb5, 0x7f, 0xe0, 0xd6, 0xf6, 0xf6, 0x31, 0xb6, 0xdc, 0xaa, 0x8f, 0xa2, 0x0e, 0x0f,
+	0xd1, 0x51, 0xb1, 0x12, 0x94, 0x49, 0x0d, 0x2e, 0x32, 0xa6, 0xde, 0x03, 0x3b, 0x91, 0xb1, 0xd6,
+	0xd6, 0x0c, 0x37, 0x62, 0x70, 0xf1, 0xf1, 0x6e, 0xe1, 0x39, 0xf7, 0x0b, 0xcf, 0xf9, 0xb7, 0xf0,
+	0x9c, 0xdf, 0x4b, 0xaf, 0x72, 0xbf, 0xf4, 0x2a, 0x7f, 0x96, 0x5e, 0xe5, 0x6b, 0x10, 0x33, 0x3d,
+	0x9b, 0x4f, 0xfd, 0x50, 0xf0, 0x20, 0xb3, 0x83, 0x14, 0x4a, 0x9b, 0xa7, 0xb7, 0xe1, 0x8c, 0xb0,
+	0x34, 0xf8, 0x59, 0x5c, 0x30, 0x7d, 0x2b, 0x29, 0x4c, 0xab, 0xe6, 0x5a, 0xbd, 0xfb, 0x3f, 0x00,
+	0xa2, 0xea, 0x11, 0xd3, 0x14, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +211,111 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimReceipts) > 0 {
+		for iNdEx := len(m.ClaimReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AllocationUploads) > 0 {
+		for iNdEx := len(m.AllocationUploads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationUploads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MerkleClaimedWords) > 0 {
+		for iNdEx := len(m.MerkleClaimedWords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleClaimedWords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for iNdEx := len(m.MerkleAirdrops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleAirdrops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClaimRecords) > 0 {
+		for iNdEx := len(m.ClaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Allocations) > 0 {
 		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -184,6 +368,52 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClaimRecords) > 0 {
+		for _, e := range m.ClaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleAirdrops) > 0 {
+		for _, e := range m.MerkleAirdrops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleClaimedWords) > 0 {
+		for _, e := range m.MerkleClaimedWords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllocationUploads) > 0 {
+		for _, e := range m.AllocationUploads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimReceipts) > 0 {
+		for _, e := range m.ClaimReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -289,6 +519,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecords = append(m.ClaimRecords, ClaimRecord{})
+			if err := m.ClaimRecords[len(m.ClaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleAirdrops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleAirdrops = append(m.MerkleAirdrops, MerkleAirdrop{})
+			if err := m.MerkleAirdrops[len(m.MerkleAirdrops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleClaimedWords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleClaimedWords = append(m.MerkleClaimedWords, MerkleClaimedWord{})
+			if err := m.MerkleClaimedWords[len(m.MerkleClaimedWords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationUploads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationUploads = append(m.AllocationUploads, AllocationUpload{})
+			if err := m.AllocationUploads[len(m.AllocationUploads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimReceipts = append(m.ClaimReceipts, ClaimReceipt{})
+			if err := m.ClaimReceipts[len(m.ClaimReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
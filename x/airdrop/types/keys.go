@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "airdrop"
@@ -15,5 +20,91 @@ const (
 )
 
 var (
-	KeyPrefixAirdropAllocation = []byte{0x01}
+	KeyPrefixAirdropAllocation          = []byte{0x01}
+	KeyPrefixClaimRecord                = []byte{0x02}
+	KeyPrefixClaimRecordByRewardAddress = []byte{0x03}
+	KeyPrefixMerkleAirdrop              = []byte{0x04}
+	KeyPrefixMerkleClaimedWord          = []byte{0x05}
+	KeyLastMerkleAirdropId              = []byte{0x06}
+	KeyPrefixCampaign                   = []byte{0x07}
+	KeyLastCampaignId                   = []byte{0x08}
+	KeyFeelessClaimCount                = []byte{0x09}
+	KeyPrefixAllocationUpload           = []byte{0x0a}
+	KeyPrefixReservedAmount             = []byte{0x0b}
+	KeyPendingOwner                     = []byte{0x0c}
+	KeyPrefixSnapshot                   = []byte{0x0d}
+	KeyPrefixClaimReceipt               = []byte{0x0e}
+	KeyPrefixClaimReceiptByRewardAddr   = []byte{0x0f}
+	KeyLastClaimReceiptId               = []byte{0x10}
+	KeyPrefixDelegationShares           = []byte{0x11}
 )
+
+// GetAllocationKey returns the key of the allocation of an address in a campaign
+func GetAllocationKey(campaignId uint64, addr string) []byte {
+	return append(append(KeyPrefixAirdropAllocation, sdk.Uint64ToBigEndian(campaignId)...), []byte(addr)...)
+}
+
+// GetClaimRecordKey returns the key of the claim record of an address in a campaign
+func GetClaimRecordKey(campaignId uint64, addr string) []byte {
+	return append(append(KeyPrefixClaimRecord, sdk.Uint64ToBigEndian(campaignId)...), []byte(addr)...)
+}
+
+// GetClaimRecordByRewardAddressPrefix returns the index prefix of claim records for a reward address
+func GetClaimRecordByRewardAddressPrefix(rewardAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixClaimRecordByRewardAddress, address.MustLengthPrefix(rewardAddr)...)
+}
+
+// GetClaimRecordByRewardAddressKey returns the index key of a claim record of a campaign for a reward address
+func GetClaimRecordByRewardAddressKey(rewardAddr sdk.AccAddress, campaignId uint64, addr string) []byte {
+	return append(append(GetClaimRecordByRewardAddressPrefix(rewardAddr), sdk.Uint64ToBigEndian(campaignId)...), []byte(addr)...)
+}
+
+// GetMerkleAirdropKey returns the key of a merkle airdrop
+func GetMerkleAirdropKey(id uint64) []byte {
+	return append(KeyPrefixMerkleAirdrop, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMerkleClaimedWordKey returns the key of a claimed bitmap word of a merkle airdrop
+func GetMerkleClaimedWordKey(id uint64, wordIndex uint64) []byte {
+	return append(append(KeyPrefixMerkleClaimedWord, sdk.Uint64ToBigEndian(id)...), sdk.Uint64ToBigEndian(wordIndex)...)
+}
+
+// GetAllocationUploadKey returns the key of the allocation upload of a campaign
+func GetAllocationUploadKey(campaignId uint64) []byte {
+	return append(KeyPrefixAllocationUpload, sdk.Uint64ToBigEndian(campaignId)...)
+}
+
+// GetReservedAmountKey returns the key of the amount reserved by allocations of a campaign in a denom
+func GetReservedAmountKey(campaignId uint64, denom string) []byte {
+	return append(append(KeyPrefixReservedAmount, sdk.Uint64ToBigEndian(campaignId)...), []byte(denom)...)
+}
+
+// GetSnapshotKey returns the key of the snapshot of a campaign
+func GetSnapshotKey(campaignId uint64) []byte {
+	return append(KeyPrefixSnapshot, sdk.Uint64ToBigEndian(campaignId)...)
+}
+
+// GetClaimReceiptKey returns the key of a claim receipt
+func GetClaimReceiptKey(id uint64) []byte {
+	return append(KeyPrefixClaimReceipt, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetClaimReceiptByRewardAddressPrefix returns the index prefix of claim receipts for a reward address
+func GetClaimReceiptByRewardAddressPrefix(rewardAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixClaimReceiptByRewardAddr, address.MustLengthPrefix(rewardAddr)...)
+}
+
+// GetClaimReceiptByRewardAddressKey returns the index key of a claim receipt for a reward address
+func GetClaimReceiptByRewardAddressKey(rewardAddr sdk.AccAddress, id uint64) []byte {
+	return append(GetClaimReceiptByRewardAddressPrefix(rewardAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetDelegationSharesKey returns the key of the shares of a delegation recorded before they are modified
+func GetDelegationSharesKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(KeyPrefixDelegationShares, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCampaignKey returns the key of a campaign
+func GetCampaignKey(id uint64) []byte {
+	return append(KeyPrefixCampaign, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// MerkleLeafPrefix and MerkleNodePrefix separate the hashes of leaves from the hashes of inner nodes,
+	// so that an inner node cannot be proven as a leaf.
+	MerkleLeafPrefix = []byte{0x00}
+	MerkleNodePrefix = []byte{0x01}
+)
+
+// MerkleLeafHash returns the leaf hash of an allocation in a merkle airdrop
+func MerkleLeafHash(index uint64, chain string, address string, amount sdk.Coin) []byte {
+	leaf := fmt.Sprintf("%d,%s,%s,%s", index, chain, address, amount.String())
+	hash := sha256.Sum256(append(append([]byte{}, MerkleLeafPrefix...), leaf...))
+	return hash[:]
+}
+
+// MerkleNodeHash returns the parent hash of two nodes, hashed in sorted order
+// so that proofs do not need to carry the position of siblings.
+func MerkleNodeHash(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	hash := sha256.Sum256(append(append(append([]byte{}, MerkleNodePrefix...), a...), b...))
+	return hash[:]
+}
+
+// VerifyMerkleProof checks the hex encoded proof of a leaf against a hex encoded root
+func VerifyMerkleProof(root string, leaf []byte, proof []string) bool {
+	rootBytes, err := hex.DecodeString(root)
+	if err != nil {
+		return false
+	}
+
+	hash := leaf
+	for _, sibling := range proof {
+		siblingBytes, err := hex.DecodeString(sibling)
+		if err != nil {
+			return false
+		}
+		hash = MerkleNodeHash(hash, siblingBytes)
+	}
+	return bytes.Equal(hash, rootBytes)
+}
+
+// BuildMerkleTree returns the root of the leaves and the proof of each leaf.
+// A node without sibling is promoted to the next level unchanged.
+func BuildMerkleTree(leaves [][]byte) ([]byte, [][][]byte) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	proofs := make([][][]byte, len(leaves))
+	// positions tracks the node index of each leaf at the current level
+	positions := make([]int, len(leaves))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		for i, pos := range positions {
+			sibling := pos ^ 1
+			if sibling < len(level) {
+				proofs[i] = append(proofs[i], level[sibling])
+			}
+			positions[i] = pos / 2
+		}
+
+		next := [][]byte{}
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, MerkleNodeHash(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+
+	return level[0], proofs
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for _, numLeaves := range []int{1, 2, 3, 5, 8} {
+		leaves := [][]byte{}
+		for i := 0; i < numLeaves; i++ {
+			leaves = append(leaves, MerkleLeafHash(uint64(i), "cosmos", "cosmos1address", sdk.NewInt64Coin("ufury", int64(i+1))))
+		}
+
+		root, proofs := BuildMerkleTree(leaves)
+		rootHex := hex.EncodeToString(root)
+		for i, leaf := range leaves {
+			proof := []string{}
+			for _, sibling := range proofs[i] {
+				proof = append(proof, hex.EncodeToString(sibling))
+			}
+			require.True(t, VerifyMerkleProof(rootHex, leaf, proof))
+
+			// tampered amount must not verify
+			tampered := MerkleLeafHash(uint64(i), "cosmos", "cosmos1address", sdk.NewInt64Coin("ufury", 1000))
+			require.False(t, VerifyMerkleProof(rootHex, tampered, proof))
+		}
+	}
+}
+
+func TestMerkleHashDomainSeparation(t *testing.T) {
+	// leaves are hashed with a 0x00 prefix and inner nodes with a 0x01 prefix
+	leaf0 := MerkleLeafHash(0, "cosmos", "cosmos1address", sdk.NewInt64Coin("ufury", 1))
+	leaf1 := MerkleLeafHash(1, "cosmos", "cosmos1address", sdk.NewInt64Coin("ufury", 2))
+	require.Equal(t, "ee26182d295d7f1e4c56948e9d45701e68ea16a85fa1eb78f4b41a95ce322d8b", hex.EncodeToString(leaf0))
+	require.Equal(t, "1991d57796870c0edabe57114e1aad9d5330375b6188b120f658767b1394d60b", hex.EncodeToString(leaf1))
+	node := MerkleNodeHash(leaf1, leaf0)
+	require.Equal(t, "50ec5945a3d13a6c941568d952829524f9afe00a5c81ccccba73d99ce7afbe74", hex.EncodeToString(node))
+
+	// an inner node proven with its sibling is not accepted as a leaf of the tree
+	leaf2 := MerkleLeafHash(2, "cosmos", "cosmos1address", sdk.NewInt64Coin("ufury", 3))
+	root, proofs := BuildMerkleTree([][]byte{leaf0, leaf1, leaf2})
+	require.True(t, VerifyMerkleProof(hex.EncodeToString(root), leaf2, []string{hex.EncodeToString(proofs[2][0])}))
+	require.Equal(t, node, proofs[2][0])
+	unprefixed := sha256.Sum256(append(append([]byte{}, leaf0...), leaf1...))
+	require.NotEqual(t, unprefixed[:], node)
+}
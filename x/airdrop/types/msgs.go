@@ -1,7 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ sdk.Msg = &MsgClaimAllocation{}
@@ -37,9 +42,59 @@ func (m *MsgClaimAllocation) ValidateBasic() error {
 		return ErrEmptyOnChainAllocationAddress
 	}
 
+	if m.Amount != nil && (!m.Amount.IsValid() || !m.Amount.IsPositive()) {
+		return ErrInvalidClaimAmount
+	}
+
+	for _, destination := range m.Destinations {
+		if _, err := sdk.AccAddressFromBech32(destination.Address); err != nil {
+			return err
+		}
+		if !destination.Amount.IsValid() || !destination.Amount.IsPositive() {
+			return ErrInvalidClaimAmount
+		}
+	}
+	if m.Amount != nil && len(m.Destinations) > 0 && !ClaimDestinationsMatch(m.Destinations, *m.Amount) {
+		return ErrInvalidClaimDestinations
+	}
+
+	if !m.DelegatePercentage.IsNil() {
+		if m.DelegatePercentage.IsNegative() || m.DelegatePercentage.GT(sdk.OneDec()) {
+			return ErrInvalidDelegatePercentage
+		}
+		if m.DelegatePercentage.IsPositive() {
+			if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// ClaimDestinationsTotal returns the total amount of the claim destinations,
+// a zero amount without denom when they are empty or use different denoms.
+func ClaimDestinationsTotal(destinations []ClaimDestination) sdk.Coin {
+	total := sdk.Coin{Amount: sdk.ZeroInt()}
+	if len(destinations) == 0 {
+		return total
+	}
+	total.Denom = destinations[0].Amount.Denom
+	for _, destination := range destinations {
+		if destination.Amount.Denom != total.Denom {
+			return sdk.Coin{Amount: sdk.ZeroInt()}
+		}
+		total.Amount = total.Amount.Add(destination.Amount.Amount)
+	}
+	return total
+}
+
+// ClaimDestinationsMatch returns true if the claim destinations add up to the amount.
+func ClaimDestinationsMatch(destinations []ClaimDestination, amount sdk.Coin) bool {
+	total := ClaimDestinationsTotal(destinations)
+	return total.Denom == amount.Denom && total.Amount.Equal(amount.Amount)
+}
+
 func (m *MsgClaimAllocation) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(m)
 	return sdk.MustSortJSON(bz)
@@ -82,6 +137,10 @@ func (m *MsgSetAllocation) ValidateBasic() error {
 		return ErrEmptyAddress
 	}
 
+	if err := m.Allocation.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -100,6 +159,87 @@ func (m *MsgSetAllocation) GetSigners() []sdk.AccAddress {
 	}
 }
 
+var _ sdk.Msg = &MsgSetAllocations{}
+
+var MsgTypeSetAllocations = "set_allocations"
+
+func NewMsgSetAllocations(
+	sender string,
+	campaignId uint64,
+	allocations []AirdropAllocation,
+	prevChecksum string,
+	checksum string,
+	total sdk.Coin,
+) *MsgSetAllocations {
+	return &MsgSetAllocations{
+		Sender:       sender,
+		CampaignId:   campaignId,
+		Allocations:  allocations,
+		PrevChecksum: prevChecksum,
+		Checksum:     checksum,
+		Total:        total,
+	}
+}
+
+func (m *MsgSetAllocations) Route() string {
+	return ModuleName
+}
+
+func (m *MsgSetAllocations) Type() string {
+	return MsgTypeSetAllocations
+}
+
+func (m *MsgSetAllocations) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+	if m.Total.IsNil() || !m.Total.IsValid() || m.Total.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAllocationsTotal, "invalid total: %s", m.Total)
+	}
+	if len(m.Allocations) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty allocations batch")
+	}
+
+	for _, allocation := range m.Allocations {
+		if allocation.Chain == "" || allocation.Address == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allocation without chain or address")
+		}
+		if allocation.CampaignId != m.CampaignId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "allocation of %s is not in campaign %d", allocation.Address, m.CampaignId)
+		}
+		if allocation.Amount.IsNil() || allocation.Amount.Denom != m.Total.Denom || !allocation.Amount.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allocation amount of %s", allocation.Address)
+		}
+		if allocation.ClaimedAmount.IsNil() || allocation.ClaimedAmount.Denom != m.Total.Denom || allocation.Amount.IsLT(allocation.ClaimedAmount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allocation claimed amount of %s", allocation.Address)
+		}
+	}
+
+	checksum, err := AllocationsChecksum(m.PrevChecksum, m.Allocations)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidAllocationsChecksum, err.Error())
+	}
+	if checksum != m.Checksum {
+		return sdkerrors.Wrapf(ErrInvalidAllocationsChecksum, "expected %s, got %s", checksum, m.Checksum)
+	}
+	return nil
+}
+
+func (m *MsgSetAllocations) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetAllocations) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
 var _ sdk.Msg = &MsgTransferModuleOwnership{}
 
 var MsgTypeTransferModuleOwnership = "transfer_module_ownership"
@@ -119,7 +259,7 @@ func (m *MsgTransferModuleOwnership) Route() string {
 }
 
 func (m *MsgTransferModuleOwnership) Type() string {
-	return MsgTypeSetAllocation
+	return MsgTypeTransferModuleOwnership
 }
 
 func (m *MsgTransferModuleOwnership) ValidateBasic() error {
@@ -127,6 +267,12 @@ func (m *MsgTransferModuleOwnership) ValidateBasic() error {
 		return ErrEmptyAddress
 	}
 
+	if m.NewOwner != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -145,6 +291,49 @@ func (m *MsgTransferModuleOwnership) GetSigners() []sdk.AccAddress {
 	}
 }
 
+var _ sdk.Msg = &MsgAcceptModuleOwnership{}
+
+var MsgTypeAcceptModuleOwnership = "accept_module_ownership"
+
+func NewMsgAcceptModuleOwnership(
+	sender sdk.AccAddress,
+) *MsgAcceptModuleOwnership {
+	return &MsgAcceptModuleOwnership{
+		Sender: sender.String(),
+	}
+}
+
+func (m *MsgAcceptModuleOwnership) Route() string {
+	return ModuleName
+}
+
+func (m *MsgAcceptModuleOwnership) Type() string {
+	return MsgTypeAcceptModuleOwnership
+}
+
+func (m *MsgAcceptModuleOwnership) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	return nil
+}
+
+func (m *MsgAcceptModuleOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgAcceptModuleOwnership) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
 var _ sdk.Msg = &MsgSignData{}
 
 var MsgTypeSignData = "sign_data"
@@ -186,10 +375,12 @@ var MsgTypeDepositTokens = "deposit_tokens"
 func NewMsgDepositTokens(
 	sender sdk.AccAddress,
 	amount sdk.Coins,
+	campaignId uint64,
 ) *MsgDepositTokens {
 	return &MsgDepositTokens{
-		Sender: sender.String(),
-		Amount: amount,
+		Sender:     sender.String(),
+		Amount:     amount,
+		CampaignId: campaignId,
 	}
 }
 
@@ -223,3 +414,304 @@ func (m *MsgDepositTokens) GetSigners() []sdk.AccAddress {
 		addr,
 	}
 }
+
+var _ sdk.Msg = &MsgCreateMerkleAirdrop{}
+
+var MsgTypeCreateMerkleAirdrop = "create_merkle_airdrop"
+
+func NewMsgCreateMerkleAirdrop(
+	sender sdk.AccAddress,
+	merkleRoot string,
+	totalAmount sdk.Coin,
+	campaignId uint64,
+) *MsgCreateMerkleAirdrop {
+	return &MsgCreateMerkleAirdrop{
+		Sender:      sender.String(),
+		MerkleRoot:  merkleRoot,
+		TotalAmount: totalAmount,
+		CampaignId:  campaignId,
+	}
+}
+
+func (m *MsgCreateMerkleAirdrop) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCreateMerkleAirdrop) Type() string {
+	return MsgTypeCreateMerkleAirdrop
+}
+
+func (m *MsgCreateMerkleAirdrop) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	root, err := hex.DecodeString(m.MerkleRoot)
+	if err != nil || len(root) != sha256.Size {
+		return ErrInvalidMerkleRoot
+	}
+
+	return nil
+}
+
+func (m *MsgCreateMerkleAirdrop) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateMerkleAirdrop) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+var _ sdk.Msg = &MsgCreateCampaign{}
+
+var MsgTypeCreateCampaign = "create_campaign"
+
+func NewMsgCreateCampaign(
+	sender sdk.AccAddress,
+	denom string,
+	startTime time.Time,
+	endTime time.Time,
+	vesting VestingParams,
+) *MsgCreateCampaign {
+	return &MsgCreateCampaign{
+		Sender:    sender.String(),
+		Denom:     denom,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Vesting:   vesting,
+	}
+}
+
+func (m *MsgCreateCampaign) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCreateCampaign) Type() string {
+	return MsgTypeCreateCampaign
+}
+
+func (m *MsgCreateCampaign) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if !m.EndTime.IsZero() && m.EndTime.Before(m.StartTime) {
+		return ErrInvalidCampaignWindow
+	}
+
+	if err := m.Vesting.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVestingParams, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgCreateCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+var _ sdk.Msg = &MsgRevokeAllocation{}
+
+var MsgTypeRevokeAllocation = "revoke_allocation"
+
+func NewMsgRevokeAllocation(
+	sender sdk.AccAddress,
+	campaignId uint64,
+	address string,
+) *MsgRevokeAllocation {
+	return &MsgRevokeAllocation{
+		Sender:     sender.String(),
+		Address:    address,
+		CampaignId: campaignId,
+	}
+}
+
+func (m *MsgRevokeAllocation) Route() string {
+	return ModuleName
+}
+
+func (m *MsgRevokeAllocation) Type() string {
+	return MsgTypeRevokeAllocation
+}
+
+func (m *MsgRevokeAllocation) ValidateBasic() error {
+	if m.Sender == "" || m.Address == "" {
+		return ErrEmptyAddress
+	}
+
+	return nil
+}
+
+func (m *MsgRevokeAllocation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRevokeAllocation) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+var _ sdk.Msg = &MsgWithdrawTokens{}
+
+var MsgTypeWithdrawTokens = "withdraw_tokens"
+
+func NewMsgWithdrawTokens(
+	sender sdk.AccAddress,
+	campaignId uint64,
+	amount sdk.Coins,
+	recipient string,
+) *MsgWithdrawTokens {
+	return &MsgWithdrawTokens{
+		Sender:     sender.String(),
+		CampaignId: campaignId,
+		Amount:     amount,
+		Recipient:  recipient,
+	}
+}
+
+func (m *MsgWithdrawTokens) Route() string {
+	return ModuleName
+}
+
+func (m *MsgWithdrawTokens) Type() string {
+	return MsgTypeWithdrawTokens
+}
+
+func (m *MsgWithdrawTokens) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	amount := sdk.Coins(m.Amount)
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
+
+func (m *MsgWithdrawTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgWithdrawTokens) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
+
+// RecipientOrSender returns the address receiving the withdrawn tokens
+func (m *MsgWithdrawTokens) RecipientOrSender() string {
+	if m.Recipient == "" {
+		return m.Sender
+	}
+	return m.Recipient
+}
+
+var _ sdk.Msg = &MsgCreateSnapshotCampaign{}
+
+var MsgTypeCreateSnapshotCampaign = "create_snapshot_campaign"
+
+func NewMsgCreateSnapshotCampaign(
+	sender sdk.AccAddress,
+	owner string,
+	startTime time.Time,
+	endTime time.Time,
+	vesting VestingParams,
+	snapshot SnapshotParams,
+) *MsgCreateSnapshotCampaign {
+	return &MsgCreateSnapshotCampaign{
+		Sender:    sender.String(),
+		Owner:     owner,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Vesting:   vesting,
+		Snapshot:  snapshot,
+	}
+}
+
+func (m *MsgCreateSnapshotCampaign) Route() string {
+	return ModuleName
+}
+
+func (m *MsgCreateSnapshotCampaign) Type() string {
+	return MsgTypeCreateSnapshotCampaign
+}
+
+func (m *MsgCreateSnapshotCampaign) ValidateBasic() error {
+	if m.Sender == "" {
+		return ErrEmptyAddress
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !m.EndTime.IsZero() && m.EndTime.Before(m.StartTime) {
+		return ErrInvalidCampaignWindow
+	}
+
+	if err := m.Vesting.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVestingParams, err.Error())
+	}
+
+	if err := m.Snapshot.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSnapshotParams, err.Error())
+	}
+
+	return nil
+}
+
+func (m *MsgCreateSnapshotCampaign) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCreateSnapshotCampaign) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		addr,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	fmt "fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,3 +31,157 @@ func TestMsgSignData(t *testing.T) {
 
 	require.NoError(t, msg.ValidateBasic())
 }
+
+func TestMsgClaimAllocationValidateBasic(t *testing.T) {
+	rewardAddr := sdk.AccAddress("reward______________")
+	otherAddr := sdk.AccAddress("other_______________")
+	valAddr := sdk.ValAddress("validator___________")
+	amount := sdk.NewInt64Coin("ufury", 100)
+	zero := sdk.NewInt64Coin("ufury", 0)
+
+	tests := []struct {
+		name string
+		msg  MsgClaimAllocation
+		err  error
+	}{
+		{
+			"whole claimable amount",
+			MsgClaimAllocation{Address: "cosmos1address", RewardAddress: rewardAddr.String()},
+			nil,
+		},
+		{
+			"zero amount",
+			MsgClaimAllocation{Address: "cosmos1address", RewardAddress: rewardAddr.String(), Amount: &zero},
+			ErrInvalidClaimAmount,
+		},
+		{
+			"destinations adding up to the amount",
+			MsgClaimAllocation{
+				Address: "cosmos1address", RewardAddress: rewardAddr.String(), Amount: &amount,
+				Destinations: []ClaimDestination{
+					{Address: rewardAddr.String(), Amount: sdk.NewInt64Coin("ufury", 60)},
+					{Address: otherAddr.String(), Amount: sdk.NewInt64Coin("ufury", 40)},
+				},
+			},
+			nil,
+		},
+		{
+			"destinations not adding up to the amount",
+			MsgClaimAllocation{
+				Address: "cosmos1address", RewardAddress: rewardAddr.String(), Amount: &amount,
+				Destinations: []ClaimDestination{
+					{Address: otherAddr.String(), Amount: sdk.NewInt64Coin("ufury", 40)},
+				},
+			},
+			ErrInvalidClaimDestinations,
+		},
+		{
+			"destinations with another denom",
+			MsgClaimAllocation{
+				Address: "cosmos1address", RewardAddress: rewardAddr.String(), Amount: &amount,
+				Destinations: []ClaimDestination{
+					{Address: otherAddr.String(), Amount: sdk.NewInt64Coin("uatom", 100)},
+				},
+			},
+			ErrInvalidClaimDestinations,
+		},
+		{
+			"delegate percentage above one",
+			MsgClaimAllocation{
+				Address: "cosmos1address", RewardAddress: rewardAddr.String(),
+				ValidatorAddress: valAddr.String(), DelegatePercentage: sdk.NewDecWithPrec(15, 1),
+			},
+			ErrInvalidDelegatePercentage,
+		},
+		{
+			"delegate percentage",
+			MsgClaimAllocation{
+				Address: "cosmos1address", RewardAddress: rewardAddr.String(),
+				ValidatorAddress: valAddr.String(), DelegatePercentage: sdk.NewDecWithPrec(5, 1),
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.err == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.err, tc.name)
+		}
+	}
+}
+
+func TestMsgSetAllocationsValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	total := sdk.NewInt64Coin("ufury", 300)
+	allocations := []AirdropAllocation{
+		{Chain: "cosmos", Address: "cosmos1address", Amount: sdk.NewInt64Coin("ufury", 100), ClaimedAmount: sdk.NewInt64Coin("ufury", 0), CampaignId: 1},
+		{Chain: "evm", Address: "0xaddress", Amount: sdk.NewInt64Coin("ufury", 200), ClaimedAmount: sdk.NewInt64Coin("ufury", 0), CampaignId: 1},
+	}
+	checksum, err := AllocationsChecksum("", allocations)
+	require.NoError(t, err)
+
+	// the running checksum of two batches is the checksum of the whole upload
+	firstChecksum, err := AllocationsChecksum("", allocations[:1])
+	require.NoError(t, err)
+	runningChecksum, err := AllocationsChecksum(firstChecksum, allocations[1:])
+	require.NoError(t, err)
+	require.Equal(t, checksum, runningChecksum)
+
+	otherCampaign := append([]AirdropAllocation{}, allocations...)
+	otherCampaign[1].CampaignId = 2
+	otherDenom := append([]AirdropAllocation{}, allocations...)
+	otherDenom[1].Amount = sdk.NewInt64Coin("uatom", 200)
+
+	tests := []struct {
+		name string
+		msg  *MsgSetAllocations
+		err  error
+	}{
+		{"valid batch", NewMsgSetAllocations(sender, 1, allocations, "", checksum, total), nil},
+		{"empty sender", NewMsgSetAllocations("", 1, allocations, "", checksum, total), ErrEmptyAddress},
+		{"zero total", NewMsgSetAllocations(sender, 1, allocations, "", checksum, sdk.NewInt64Coin("ufury", 0)), ErrInvalidAllocationsTotal},
+		{"empty batch", NewMsgSetAllocations(sender, 1, nil, "", checksum, total), sdkerrors.ErrInvalidRequest},
+		{"allocation of another campaign", NewMsgSetAllocations(sender, 1, otherCampaign, "", checksum, total), sdkerrors.ErrInvalidRequest},
+		{"allocation of another denom", NewMsgSetAllocations(sender, 1, otherDenom, "", checksum, total), sdkerrors.ErrInvalidCoins},
+		{"checksum of another batch", NewMsgSetAllocations(sender, 1, allocations, "", firstChecksum, total), ErrInvalidAllocationsChecksum},
+		{"checksum following another batch", NewMsgSetAllocations(sender, 1, allocations, firstChecksum, checksum, total), ErrInvalidAllocationsChecksum},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.err == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.err, tc.name)
+		}
+	}
+}
+
+func TestMsgWithdrawTokensValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	amount := sdk.Coins{sdk.NewInt64Coin("ufury", 100)}
+
+	tests := []struct {
+		name string
+		msg  *MsgWithdrawTokens
+		err  error
+	}{
+		{"valid withdrawal", NewMsgWithdrawTokens(sender, 1, amount, ""), nil},
+		{"valid withdrawal to a recipient", NewMsgWithdrawTokens(sender, 0, amount, sdk.AccAddress("recipient___________").String()), nil},
+		{"empty sender", &MsgWithdrawTokens{CampaignId: 1, Amount: amount}, ErrEmptyAddress},
+		{"invalid recipient", NewMsgWithdrawTokens(sender, 1, amount, "cosmos1invalid"), sdkerrors.ErrInvalidAddress},
+		{"empty amount", NewMsgWithdrawTokens(sender, 1, sdk.Coins{}, ""), sdkerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.err == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.err, tc.name)
+		}
+	}
+}
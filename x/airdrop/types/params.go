@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -10,12 +12,26 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 
 // parameter keys
 var (
-	KeyOwner = []byte("Owner")
+	KeyOwner                     = []byte("Owner")
+	KeyLegacySignMessageDeadline = []byte("LegacySignMessageDeadline")
+	KeyMaxFeelessClaimsPerBlock  = []byte("MaxFeelessClaimsPerBlock")
+	KeyFeelessClaimGasLimit      = []byte("FeelessClaimGasLimit")
+)
+
+var (
+	// DefaultLegacySignMessageDeadline is the default deadline of legacy sign messages at genesis.
+	DefaultLegacySignMessageDeadline = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	// LegacySignMessageUpgradePeriod is how long legacy sign messages remain accepted on chains
+	// upgraded before the legacy sign message deadline existed.
+	LegacySignMessageUpgradePeriod = 90 * 24 * time.Hour
 )
 
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyOwner, &p.Owner, validateOwner),
+		paramtypes.NewParamSetPair(KeyLegacySignMessageDeadline, &p.LegacySignMessageDeadline, validateLegacySignMessageDeadline),
+		paramtypes.NewParamSetPair(KeyMaxFeelessClaimsPerBlock, &p.MaxFeelessClaimsPerBlock, validateUint64),
+		paramtypes.NewParamSetPair(KeyFeelessClaimGasLimit, &p.FeelessClaimGasLimit, validateUint64),
 	}
 }
 
@@ -34,7 +50,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams return the default params
 func DefaultParams() Params {
 	return Params{
-		Owner: "furya16w6chfrrg930cqcfewdzse6szgjk657764dll7",
+		Owner:                     "furya16w6chfrrg930cqcfewdzse6szgjk657764dll7",
+		LegacySignMessageDeadline: DefaultLegacySignMessageDeadline,
+		MaxFeelessClaimsPerBlock:  50,
+		FeelessClaimGasLimit:      400000,
 	}
 }
 
@@ -43,12 +62,45 @@ func ValidateParams(p Params) error {
 	if err := validateOwner(p.Owner); err != nil {
 		return err
 	}
+	if err := validateLegacySignMessageDeadline(p.LegacySignMessageDeadline); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxFeelessClaimsPerBlock); err != nil {
+		return err
+	}
+	if err := validateUint64(p.FeelessClaimGasLimit); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// validateOwner accepts any well formed owner address, an account, the governance module account
+// or a contract such as a multisig, regardless of the bech32 prefix of the chain.
 func validateOwner(i interface{}) error {
-	_, ok := i.(string)
+	owner, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if owner == "" {
+		return nil
+	}
+	if _, _, err := bech32.DecodeAndConvert(owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+func validateLegacySignMessageDeadline(i interface{}) error {
+	_, ok := i.(time.Time)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
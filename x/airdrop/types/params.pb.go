@@ -7,17 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,13 +31,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the module's parameters.
 type Params struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// claims signed over the legacy sign message, not bound to the chain id and campaign,
+	// are accepted until this time
+	LegacySignMessageDeadline time.Time `protobuf:"bytes,2,opt,name=legacy_sign_message_deadline,json=legacySignMessageDeadline,proto3,stdtime" json:"legacy_sign_message_deadline"`
+	// max number of fee-less claims from nonexistent accounts per block, zero for no limit
+	MaxFeelessClaimsPerBlock uint64 `protobuf:"varint,3,opt,name=max_feeless_claims_per_block,json=maxFeelessClaimsPerBlock,proto3" json:"max_feeless_claims_per_block,omitempty"`
+	// gas limit of the trial claim run before accepting a fee-less claim, zero for no limit
+	FeelessClaimGasLimit uint64 `protobuf:"varint,4,opt,name=feeless_claim_gas_limit,json=feelessClaimGasLimit,proto3" json:"feeless_claim_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2c3d8a029e35b4d, []int{0}
+	return fileDescriptor_daa146d73df52725, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -70,30 +80,60 @@ func (m *Params) GetOwner() string {
 	return ""
 }
 
+func (m *Params) GetLegacySignMessageDeadline() time.Time {
+	if m != nil {
+		return m.LegacySignMessageDeadline
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetMaxFeelessClaimsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxFeelessClaimsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeelessClaimGasLimit() uint64 {
+	if m != nil {
+		return m.FeelessClaimGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "furya.airdrop.v1beta1.Params")
 }
 
 func init() {
-	proto.RegisterFile("furya/airdrop/v1beta1/params.proto", fileDescriptor_a2c3d8a029e35b4d)
+	proto.RegisterFile("furya/airdrop/v1beta1/params.proto", fileDescriptor_daa146d73df52725)
 }
 
-var fileDescriptor_a2c3d8a029e35b4d = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x49, 0x2d, 0xca,
-	0x2c, 0xc9, 0x2f, 0xca, 0xd4, 0x4f, 0xcc, 0x2c, 0x4a, 0x29, 0xca, 0x2f, 0xd0, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0xe4, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52,
-	0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x94, 0xd2, 0xa2, 0xc4, 0x92, 0xcc, 0xfc, 0x3c, 0xa8,
-	0xbc, 0x3c, 0xba, 0x7c, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e, 0x01, 0x44, 0x81, 0x92,
-	0x1c, 0x17, 0x5b, 0x00, 0xd8, 0x01, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93, 0xcf, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x87, 0xb8, 0x06, 0x79, 0x86, 0xf8, 0x07, 0x79, 0xea, 0xc3, 0x9c, 0xaf, 0x9b, 0x9c, 0x91, 0x98,
-	0x99, 0xa7, 0x5f, 0x01, 0xf7, 0x6d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x52, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0xc3, 0xc9, 0x03, 0x0e, 0x01, 0x00, 0x00,
+var fileDescriptor_daa146d73df52725 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x8f, 0xd3, 0x40,
+	0x10, 0x85, 0xbd, 0x10, 0x22, 0x30, 0x9d, 0x15, 0x84, 0x89, 0x22, 0x27, 0x4a, 0x95, 0x06, 0xaf,
+	0x02, 0xa2, 0xa5, 0x08, 0x08, 0x84, 0x04, 0x52, 0x14, 0xa8, 0x68, 0x56, 0x63, 0x7b, 0xbc, 0x59,
+	0xe1, 0xf5, 0x5a, 0xbb, 0x6b, 0x2e, 0xf9, 0x17, 0xf9, 0x59, 0x29, 0x53, 0x5e, 0x75, 0x77, 0x4a,
+	0xfe, 0xc6, 0x15, 0x27, 0xaf, 0x9d, 0xd3, 0xdd, 0x75, 0x33, 0x7a, 0xdf, 0x7b, 0x4f, 0xa3, 0xf1,
+	0xa7, 0x79, 0xad, 0xb7, 0x40, 0x41, 0xe8, 0x4c, 0xab, 0x8a, 0xfe, 0x9f, 0x27, 0x68, 0x61, 0x4e,
+	0x2b, 0xd0, 0x20, 0x4d, 0x5c, 0x69, 0x65, 0x55, 0xf0, 0xc6, 0x31, 0x71, 0xc7, 0xc4, 0x1d, 0x33,
+	0x1c, 0x70, 0xc5, 0x95, 0x23, 0x68, 0x33, 0xb5, 0xf0, 0x30, 0xe2, 0x4a, 0xf1, 0x02, 0xa9, 0xdb,
+	0x92, 0x3a, 0xa7, 0x59, 0xad, 0xc1, 0x0a, 0x55, 0x76, 0xfa, 0xf8, 0xa9, 0x6e, 0x85, 0x44, 0x63,
+	0x41, 0x56, 0x2d, 0x30, 0xbd, 0x25, 0x7e, 0x7f, 0xe9, 0xea, 0x83, 0x81, 0xff, 0x42, 0x5d, 0x94,
+	0xa8, 0x43, 0x32, 0x21, 0xb3, 0x57, 0xab, 0x76, 0x09, 0xd0, 0x1f, 0x15, 0xc8, 0x21, 0xdd, 0x32,
+	0x23, 0x78, 0xc9, 0x24, 0x1a, 0x03, 0x1c, 0x59, 0x86, 0x90, 0x15, 0xa2, 0xc4, 0xf0, 0xd9, 0x84,
+	0xcc, 0x5e, 0x7f, 0x18, 0xc6, 0x6d, 0x51, 0x7c, 0x2e, 0x8a, 0xff, 0x9c, 0x8b, 0x16, 0x2f, 0xf7,
+	0x57, 0x63, 0x6f, 0x77, 0x3d, 0x26, 0xab, 0x77, 0x6d, 0xd2, 0x6f, 0xc1, 0xcb, 0x5f, 0x6d, 0xce,
+	0xd7, 0x2e, 0x26, 0xf8, 0xec, 0x8f, 0x24, 0x6c, 0x58, 0x8e, 0x58, 0xa0, 0x31, 0x2c, 0x2d, 0x40,
+	0x48, 0xc3, 0x2a, 0xd4, 0x2c, 0x29, 0x54, 0xfa, 0x2f, 0x7c, 0x3e, 0x21, 0xb3, 0xde, 0x2a, 0x94,
+	0xb0, 0xf9, 0xd6, 0x22, 0x5f, 0x1c, 0xb1, 0x44, 0xbd, 0x68, 0xf4, 0xe0, 0x93, 0xff, 0xf6, 0x91,
+	0x97, 0x71, 0x30, 0xac, 0x10, 0x52, 0xd8, 0xb0, 0xe7, 0xac, 0x83, 0xfc, 0x81, 0xef, 0x3b, 0x98,
+	0x9f, 0x8d, 0xb6, 0xf8, 0xb1, 0x3f, 0x46, 0xe4, 0x70, 0x8c, 0xc8, 0xcd, 0x31, 0x22, 0xbb, 0x53,
+	0xe4, 0x1d, 0x4e, 0x91, 0x77, 0x79, 0x8a, 0xbc, 0xbf, 0x94, 0x0b, 0xbb, 0xae, 0x93, 0x38, 0x55,
+	0x92, 0x36, 0x1f, 0x31, 0x95, 0xd2, 0xd6, 0x4d, 0xef, 0xd3, 0x35, 0x88, 0x92, 0x6e, 0xee, 0xdf,
+	0x68, 0xb7, 0x15, 0x9a, 0xa4, 0xef, 0x4e, 0xff, 0x78, 0x37, 0x00, 0x8f, 0xf8, 0xe1, 0x86, 0xe4,
+	0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -116,6 +156,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeelessClaimGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeelessClaimGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxFeelessClaimsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFeelessClaimsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LegacySignMessageDeadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LegacySignMessageDeadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -147,6 +205,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LegacySignMessageDeadline)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxFeelessClaimsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxFeelessClaimsPerBlock))
+	}
+	if m.FeelessClaimGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.FeelessClaimGasLimit))
+	}
 	return n
 }
 
@@ -217,6 +283,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacySignMessageDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LegacySignMessageDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeelessClaimsPerBlock", wireType)
+			}
+			m.MaxFeelessClaimsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeelessClaimsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeelessClaimGasLimit", wireType)
+			}
+			m.FeelessClaimGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeelessClaimGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeOwnerMsgs defines the type for an OwnerMsgsProposal
+	ProposalTypeOwnerMsgs = "AirdropOwnerMsgs"
+)
+
+var (
+	_ govtypes.Content                   = &OwnerMsgsProposal{}
+	_ codectypes.UnpackInterfacesMessage = &OwnerMsgsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeOwnerMsgs)
+	govtypes.RegisterProposalTypeCodec(&OwnerMsgsProposal{}, "furya/airdrop/OwnerMsgsProposal")
+}
+
+// GovModuleAddress returns the address of the governance module account, which signs the messages
+// of owner proposals.
+func GovModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(govtypes.ModuleName)
+}
+
+// NewOwnerMsgsProposal creates a new proposal executing the airdrop messages as the governance module account
+func NewOwnerMsgsProposal(title, description string, msgs []sdk.Msg) (*OwnerMsgsProposal, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &OwnerMsgsProposal{
+		Title:       title,
+		Description: description,
+		Messages:    anys,
+	}, nil
+}
+
+// GetTitle returns the title of the proposal
+func (p *OwnerMsgsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *OwnerMsgsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *OwnerMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *OwnerMsgsProposal) ProposalType() string { return ProposalTypeOwnerMsgs }
+
+// GetMsgs returns the unpacked messages of the proposal
+func (p *OwnerMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, any := range p.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsgs, "message %d is not a sdk.Msg: %s", i, any.TypeUrl)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// ValidateBasic validates the proposal and its messages, which must all be owner messages signed by the
+// governance module account only.
+func (p *OwnerMsgsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalMsgs, "no messages")
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsgs, "message %d: %s", i, err)
+		}
+		if err := ValidateOwnerProposalMsg(msg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+	return nil
+}
+
+// ValidateOwnerProposalMsg returns an error unless the message is an owner message signed by the governance module
+// account only. Messages spending the balance of the signer, such as deposits, would spend the proposal deposits held
+// by the governance module account and are rejected.
+func ValidateOwnerProposalMsg(msg sdk.Msg) error {
+	switch msg.(type) {
+	case *MsgSetAllocation, *MsgSetAllocations, *MsgRevokeAllocation, *MsgWithdrawTokens, *MsgCreateMerkleAirdrop,
+		*MsgCreateSnapshotCampaign, *MsgTransferModuleOwnership, *MsgAcceptModuleOwnership:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidProposalMsgs, "%s is not an owner message", sdk.MsgTypeURL(msg))
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(GovModuleAddress()) {
+		return sdkerrors.Wrapf(ErrInvalidProposalMsgs, "messages must be signed by the governance module account %s", GovModuleAddress())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p OwnerMsgsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Airdrop Owner Msgs Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, p.Title, p.Description))
+	for _, any := range p.Messages {
+		b.WriteString(fmt.Sprintf("    %s\n", any.TypeUrl))
+	}
+	return b.String()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p OwnerMsgsProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range p.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
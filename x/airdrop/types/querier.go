@@ -0,0 +1,18 @@
+package types
+
+// legacy querier routes, the request data is the amino JSON of the request of the gRPC query
+const (
+	QueryAllocation        = "allocation"
+	QueryClaimRecord       = "claim_record"
+	QueryClaimReceipts     = "claim_receipts"
+	QueryMerkleAirdrop     = "merkle_airdrop"
+	QueryMerkleLeafClaimed = "merkle_leaf_claimed"
+	QueryCampaign          = "campaign"
+	QueryCampaigns         = "campaigns"
+	QueryAllocationUpload  = "allocation_upload"
+	QueryParams            = "params"
+	QueryOwnership         = "ownership"
+	QuerySnapshot          = "snapshot"
+	QuerySolvency          = "solvency"
+	QuerySimulateClaim     = "simulate_claim"
+)
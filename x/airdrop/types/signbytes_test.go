@@ -0,0 +1,107 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMsgSignBytes pins the amino JSON sign bytes of the airdrop messages, signatures produced by
+// earlier versions, e.g. on Ledger devices, must keep verifying after an upgrade.
+func TestMsgSignBytes(t *testing.T) {
+	sender := "furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	allocation := AirdropAllocation{
+		Chain:         "evm",
+		Address:       "0x9d967594Cc61453aFEfD657313e5F05be7c6F88F",
+		Amount:        sdk.NewInt64Coin("ufury", 1000),
+		ClaimedAmount: sdk.NewInt64Coin("ufury", 0),
+		CampaignId:    1,
+	}
+	amount := sdk.NewInt64Coin("ufury", 100)
+
+	testCases := []struct {
+		name     string
+		msg      legacytx.LegacyMsg
+		expected string
+	}{
+		{
+			"claim allocation",
+			&MsgClaimAllocation{
+				Address:            allocation.Address,
+				PubKey:             "",
+				RewardAddress:      sender,
+				Signature:          "0xb89733c05568385a861fa20f5c4abe53c23a13962515bf5510638b4e3947b1236963b53de549ae762bbd45427dbd3712ae7d169a935d21e44e7da86b1c552f471b",
+				Amount:             &amount,
+				DelegatePercentage: sdk.NewDecWithPrec(5, 1),
+			},
+			`{"type":"furya/airdrop/ClaimAllocation","value":{"address":"0x9d967594Cc61453aFEfD657313e5F05be7c6F88F","amount":{"amount":"100","denom":"ufury"},"delegate_percentage":"0.500000000000000000","destinations":null,"reward_address":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","signature":"0xb89733c05568385a861fa20f5c4abe53c23a13962515bf5510638b4e3947b1236963b53de549ae762bbd45427dbd3712ae7d169a935d21e44e7da86b1c552f471b"}}`,
+		},
+		{
+			"set allocation",
+			&MsgSetAllocation{Sender: sender, Allocation: allocation},
+			`{"type":"furya/airdrop/SetAllocation","value":{"allocation":{"address":"0x9d967594Cc61453aFEfD657313e5F05be7c6F88F","amount":{"amount":"1000","denom":"ufury"},"campaign_id":"1","chain":"evm","claimed_amount":{"amount":"0","denom":"ufury"}},"sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"set allocations",
+			&MsgSetAllocations{Sender: sender, CampaignId: 1, Allocations: []AirdropAllocation{allocation}, Checksum: "abcd", Total: allocation.Amount},
+			`{"type":"furya/airdrop/SetAllocations","value":{"allocations":[{"address":"0x9d967594Cc61453aFEfD657313e5F05be7c6F88F","amount":{"amount":"1000","denom":"ufury"},"campaign_id":"1","chain":"evm","claimed_amount":{"amount":"0","denom":"ufury"}}],"campaign_id":"1","checksum":"abcd","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","total":{"amount":"1000","denom":"ufury"}}}`,
+		},
+		{
+			"transfer module ownership",
+			&MsgTransferModuleOwnership{Sender: sender, NewOwner: sender},
+			`{"type":"furya/airdrop/TransferModuleOwnership","value":{"new_owner":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"accept module ownership",
+			&MsgAcceptModuleOwnership{Sender: sender},
+			`{"type":"furya/airdrop/AcceptModuleOwnership","value":{"sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"deposit tokens",
+			&MsgDepositTokens{Sender: sender, Amount: []sdk.Coin{amount}, CampaignId: 1},
+			`{"type":"furya/airdrop/DepositTokens","value":{"amount":[{"amount":"100","denom":"ufury"}],"campaign_id":"1","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"create merkle airdrop",
+			&MsgCreateMerkleAirdrop{Sender: sender, MerkleRoot: "abcd", TotalAmount: amount, CampaignId: 1},
+			`{"type":"furya/airdrop/CreateMerkleAirdrop","value":{"campaign_id":"1","merkle_root":"abcd","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","total_amount":{"amount":"100","denom":"ufury"}}}`,
+		},
+		{
+			"create campaign",
+			&MsgCreateCampaign{Sender: sender, Denom: "ufury", StartTime: startTime, EndTime: endTime},
+			`{"type":"furya/airdrop/CreateCampaign","value":{"denom":"ufury","end_time":"2022-06-01T00:00:00Z","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","start_time":"2022-01-01T00:00:00Z","vesting":{"duration":"0"}}}`,
+		},
+		{
+			"revoke allocation",
+			&MsgRevokeAllocation{Sender: sender, Address: allocation.Address},
+			`{"type":"furya/airdrop/RevokeAllocation","value":{"address":"0x9d967594Cc61453aFEfD657313e5F05be7c6F88F","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"withdraw tokens",
+			&MsgWithdrawTokens{Sender: sender, CampaignId: 1, Amount: []sdk.Coin{amount}},
+			`{"type":"furya/airdrop/WithdrawTokens","value":{"amount":[{"amount":"100","denom":"ufury"}],"campaign_id":"1","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm"}}`,
+		},
+		{
+			"create snapshot campaign",
+			&MsgCreateSnapshotCampaign{Sender: sender, Owner: sender, StartTime: startTime, EndTime: endTime},
+			`{"type":"furya/airdrop/CreateSnapshotCampaign","value":{"end_time":"2022-06-01T00:00:00Z","owner":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","sender":"furya1hwf62gw7h39xmd69st3p487r8x3sphm268j6nm","snapshot":{"min_amount":"0","total_amount":{"amount":"0"}},"start_time":"2022-01-01T00:00:00Z","vesting":{"duration":"0"}}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, string(tc.msg.GetSignBytes()))
+
+			// the amino JSON decodes to the same message, as legacy clients submit it
+			decoded := reflect.New(reflect.TypeOf(tc.msg).Elem()).Interface()
+			require.NoError(t, ModuleCdc.LegacyAmino.UnmarshalJSON(tc.msg.GetSignBytes(), decoded))
+			require.Equal(t, tc.msg.GetSignBytes(), decoded.(legacytx.LegacyMsg).GetSignBytes())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotChain is the chain of the allocations of snapshot campaigns
+const SnapshotChain = "furya"
+
+// Validate checks the snapshot params select accounts and allocate a positive amount
+func (p SnapshotParams) Validate() error {
+	if _, ok := SnapshotType_name[int32(p.Type)]; !ok {
+		return fmt.Errorf("unknown snapshot type: %d", p.Type)
+	}
+	if _, ok := SnapshotWeighting_name[int32(p.Weighting)]; !ok {
+		return fmt.Errorf("unknown snapshot weighting: %d", p.Weighting)
+	}
+	if p.MinAmount.IsNil() || p.MinAmount.IsNegative() {
+		return fmt.Errorf("invalid snapshot min amount: %s", p.MinAmount)
+	}
+	if p.TotalAmount.IsNil() || !p.TotalAmount.IsValid() || !p.TotalAmount.IsPositive() {
+		return fmt.Errorf("invalid snapshot total amount: %s", p.TotalAmount)
+	}
+	for _, addr := range p.ExcludeAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid excluded address %s: %w", addr, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,936 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: furya/airdrop/v1beta1/snapshot.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SnapshotType defines the furya accounts measured by a snapshot.
+type SnapshotType int32
+
+const (
+	// SnapshotStakers measures the delegated bond denom of accounts.
+	SnapshotStakers SnapshotType = 0
+	// SnapshotHolders measures the liquid and delegated bond denom of accounts.
+	SnapshotHolders SnapshotType = 1
+)
+
+var SnapshotType_name = map[int32]string{
+	0: "SnapshotStakers",
+	1: "SnapshotHolders",
+}
+
+var SnapshotType_value = map[string]int32{
+	"SnapshotStakers": 0,
+	"SnapshotHolders": 1,
+}
+
+func (x SnapshotType) String() string {
+	return proto.EnumName(SnapshotType_name, int32(x))
+}
+
+func (SnapshotType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00b70f811c63ede8, []int{0}
+}
+
+// SnapshotWeighting defines how the total amount of a snapshot campaign is split.
+type SnapshotWeighting int32
+
+const (
+	// WeightingProportional splits the total amount proportionally to the measured amounts.
+	WeightingProportional SnapshotWeighting = 0
+	// WeightingEqual splits the total amount equally between the selected accounts.
+	WeightingEqual SnapshotWeighting = 1
+)
+
+var SnapshotWeighting_name = map[int32]string{
+	0: "WeightingProportional",
+	1: "WeightingEqual",
+}
+
+var SnapshotWeighting_value = map[string]int32{
+	"WeightingProportional": 0,
+	"WeightingEqual":        1,
+}
+
+func (x SnapshotWeighting) String() string {
+	return proto.EnumName(SnapshotWeighting_name, int32(x))
+}
+
+func (SnapshotWeighting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00b70f811c63ede8, []int{1}
+}
+
+// SnapshotParams defines the accounts selected by a snapshot and the allocations they receive.
+type SnapshotParams struct {
+	Type SnapshotType `protobuf:"varint,1,opt,name=type,proto3,enum=furya.airdrop.v1beta1.SnapshotType" json:"type,omitempty"`
+	// min_amount is the measured amount an account needs to be selected.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	// top_n keeps the top_n accounts with the largest measured amounts, zero keeps all of them.
+	TopN      uint32            `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	Weighting SnapshotWeighting `protobuf:"varint,4,opt,name=weighting,proto3,enum=furya.airdrop.v1beta1.SnapshotWeighting" json:"weighting,omitempty"`
+	// total_amount is the amount allocated to the selected accounts.
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_amount"`
+	// exclude_addresses are not selected, module accounts never are.
+	ExcludeAddresses []string `protobuf:"bytes,6,rep,name=exclude_addresses,json=excludeAddresses,proto3" json:"exclude_addresses,omitempty"`
+}
+
+func (m *SnapshotParams) Reset()         { *m = SnapshotParams{} }
+func (m *SnapshotParams) String() string { return proto.CompactTextString(m) }
+func (*SnapshotParams) ProtoMessage()    {}
+func (*SnapshotParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00b70f811c63ede8, []int{0}
+}
+func (m *SnapshotParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotParams.Merge(m, src)
+}
+func (m *SnapshotParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotParams proto.InternalMessageInfo
+
+func (m *SnapshotParams) GetType() SnapshotType {
+	if m != nil {
+		return m.Type
+	}
+	return SnapshotStakers
+}
+
+func (m *SnapshotParams) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *SnapshotParams) GetWeighting() SnapshotWeighting {
+	if m != nil {
+		return m.Weighting
+	}
+	return WeightingProportional
+}
+
+func (m *SnapshotParams) GetExcludeAddresses() []string {
+	if m != nil {
+		return m.ExcludeAddresses
+	}
+	return nil
+}
+
+// Snapshot records the snapshot a campaign was created from.
+type Snapshot struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// height is the block height the snapshot was taken at.
+	Height int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Params SnapshotParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// count is the number of allocations created.
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// allocated is the amount of the allocations created.
+	Allocated github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=allocated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"allocated"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00b70f811c63ede8, []int{1}
+}
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *Snapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Snapshot) GetParams() SnapshotParams {
+	if m != nil {
+		return m.Params
+	}
+	return SnapshotParams{}
+}
+
+func (m *Snapshot) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("furya.airdrop.v1beta1.SnapshotType", SnapshotType_name, SnapshotType_value)
+	proto.RegisterEnum("furya.airdrop.v1beta1.SnapshotWeighting", SnapshotWeighting_name, SnapshotWeighting_value)
+	proto.RegisterType((*SnapshotParams)(nil), "furya.airdrop.v1beta1.SnapshotParams")
+	proto.RegisterType((*Snapshot)(nil), "furya.airdrop.v1beta1.Snapshot")
+}
+
+func init() {
+	proto.RegisterFile("furya/airdrop/v1beta1/snapshot.proto", fileDescriptor_00b70f811c63ede8)
+}
+
+var fileDescriptor_00b70f811c63ede8 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xb5, 0x5b, 0x27, 0x22, 0x93, 0x12, 0xd2, 0x6d, 0x8b, 0x42, 0x0e, 0x8e, 0x55, 0xa0, 0x58,
+	0x45, 0xb5, 0xd5, 0x82, 0xc4, 0xb9, 0xa9, 0x40, 0xe4, 0x50, 0x54, 0xb9, 0x48, 0x48, 0x5c, 0xa2,
+	0x8d, 0x6d, 0xec, 0x55, 0xed, 0x5d, 0xe3, 0x5d, 0xd3, 0xe6, 0x0f, 0x50, 0x4e, 0xfc, 0x40, 0x4e,
+	0xfc, 0x4c, 0x8f, 0x3d, 0x22, 0x0e, 0x15, 0x4a, 0xce, 0x5c, 0x39, 0x23, 0x6f, 0xec, 0x34, 0x94,
+	0x4a, 0x45, 0x9c, 0xbc, 0x33, 0xf3, 0xde, 0xcc, 0xf3, 0x9b, 0x5d, 0x78, 0xf4, 0x21, 0x4b, 0x87,
+	0xd8, 0xc6, 0x24, 0xf5, 0x52, 0x96, 0xd8, 0x9f, 0x76, 0x07, 0xbe, 0xc0, 0xbb, 0x36, 0xa7, 0x38,
+	0xe1, 0x21, 0x13, 0x56, 0x92, 0x32, 0xc1, 0xd0, 0x86, 0x44, 0x59, 0x05, 0xca, 0x2a, 0x50, 0xed,
+	0xf5, 0x80, 0x05, 0x4c, 0x22, 0xec, 0xfc, 0x34, 0x03, 0x6f, 0xfe, 0x5a, 0x82, 0xc6, 0x71, 0xc1,
+	0x3f, 0xc2, 0x29, 0x8e, 0x39, 0x7a, 0x01, 0x9a, 0x18, 0x26, 0x7e, 0x4b, 0x35, 0x54, 0xb3, 0xb1,
+	0xf7, 0xd0, 0xba, 0xb1, 0x9d, 0x55, 0x92, 0xde, 0x0e, 0x13, 0xdf, 0x91, 0x04, 0x74, 0x08, 0x10,
+	0x13, 0xda, 0xc7, 0x31, 0xcb, 0xa8, 0x68, 0x2d, 0x19, 0xaa, 0x59, 0xeb, 0x5a, 0xe7, 0x97, 0x1d,
+	0xe5, 0xfb, 0x65, 0x67, 0x2b, 0x20, 0x22, 0xcc, 0x06, 0x96, 0xcb, 0x62, 0xdb, 0x65, 0x3c, 0x66,
+	0xbc, 0xf8, 0xec, 0x70, 0xef, 0xc4, 0xce, 0xe9, 0xdc, 0xea, 0x51, 0xe1, 0xd4, 0x62, 0x42, 0xf7,
+	0x65, 0x03, 0xb4, 0x06, 0x15, 0xc1, 0x92, 0x3e, 0x6d, 0x2d, 0x1b, 0xaa, 0x79, 0xd7, 0xd1, 0x04,
+	0x4b, 0xde, 0xa0, 0x57, 0x50, 0x3b, 0xf5, 0x49, 0x10, 0x0a, 0x42, 0x83, 0x96, 0x26, 0x15, 0x9a,
+	0xb7, 0x28, 0x7c, 0x57, 0xe2, 0x9d, 0x2b, 0x2a, 0x72, 0x60, 0x45, 0x30, 0x81, 0xa3, 0x52, 0x6d,
+	0x45, 0xaa, 0xb5, 0x0b, 0xb5, 0x4f, 0xfe, 0x41, 0xed, 0x01, 0x23, 0xd4, 0xa9, 0xcb, 0x26, 0x85,
+	0xe0, 0xa7, 0xb0, 0xea, 0x9f, 0xb9, 0x51, 0xe6, 0xf9, 0x7d, 0xec, 0x79, 0xa9, 0xcf, 0xb9, 0xcf,
+	0x5b, 0x55, 0x63, 0xd9, 0xac, 0x39, 0xcd, 0xa2, 0xb0, 0x5f, 0xe6, 0x37, 0x7f, 0xaa, 0x70, 0xa7,
+	0x54, 0x88, 0x3a, 0x50, 0x77, 0x71, 0x9c, 0x60, 0x12, 0xd0, 0x3e, 0xf1, 0xa4, 0xf3, 0x9a, 0x03,
+	0x65, 0xaa, 0xe7, 0xa1, 0xfb, 0x50, 0x0d, 0xa5, 0x76, 0x69, 0xeb, 0xb2, 0x53, 0x44, 0xe8, 0x00,
+	0xaa, 0x89, 0xdc, 0x9a, 0x34, 0xa9, 0xbe, 0xf7, 0xf8, 0x16, 0x2f, 0x66, 0x2b, 0xee, 0x6a, 0xf9,
+	0x7f, 0x3a, 0x05, 0x15, 0xad, 0x43, 0xc5, 0x95, 0x26, 0x68, 0x72, 0xee, 0x2c, 0x40, 0x87, 0x50,
+	0xc3, 0x51, 0xc4, 0x5c, 0x2c, 0x7c, 0xef, 0x7f, 0xed, 0xb9, 0xea, 0xb0, 0x1d, 0xc2, 0xca, 0xe2,
+	0x95, 0x41, 0x26, 0xdc, 0x2b, 0xe3, 0x63, 0x81, 0x4f, 0xfc, 0x94, 0x37, 0x95, 0xf6, 0xda, 0x68,
+	0x6c, 0x5c, 0x4f, 0x2f, 0x22, 0x5f, 0xb3, 0xc8, 0xcb, 0x91, 0xea, 0x9f, 0xc8, 0x22, 0xdd, 0xd6,
+	0x3e, 0x7f, 0xd5, 0x95, 0xed, 0x53, 0x58, 0xfd, 0x6b, 0xf5, 0xe8, 0x39, 0x6c, 0xcc, 0x83, 0xa3,
+	0x94, 0x25, 0x2c, 0x15, 0x84, 0x51, 0x1c, 0x35, 0x95, 0xf6, 0x83, 0xd1, 0xd8, 0xb8, 0xb9, 0x88,
+	0xb6, 0xa0, 0x31, 0x2f, 0xbc, 0xfc, 0x98, 0xe1, 0xa8, 0xa9, 0xb6, 0xd1, 0x68, 0x6c, 0x5c, 0xcb,
+	0xce, 0x06, 0x77, 0x7b, 0xe7, 0x13, 0x5d, 0xbd, 0x98, 0xe8, 0xea, 0x8f, 0x89, 0xae, 0x7e, 0x99,
+	0xea, 0xca, 0xc5, 0x54, 0x57, 0xbe, 0x4d, 0x75, 0xe5, 0xbd, 0xbd, 0x60, 0x58, 0xbe, 0x20, 0x9e,
+	0x8f, 0x90, 0xa7, 0x1d, 0x37, 0xc4, 0x84, 0xda, 0x67, 0xf3, 0x47, 0x2d, 0xdd, 0x1b, 0x54, 0xe5,
+	0xeb, 0x7c, 0xf6, 0x7b, 0x00, 0x68, 0x15, 0xcf, 0x41, 0xf2, 0x03, 0x00, 0x00,
+}
+
+func (m *SnapshotParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExcludeAddresses) > 0 {
+		for iNdEx := len(m.ExcludeAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludeAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludeAddresses[iNdEx])
+			i = encodeVarintSnapshot(dAtA, i, uint64(len(m.ExcludeAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Weighting != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Weighting))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopN != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allocated.Size()
+		i -= size
+		if _, err := m.Allocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Count != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSnapshot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SnapshotParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSnapshot(uint64(m.Type))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	if m.TopN != 0 {
+		n += 1 + sovSnapshot(uint64(m.TopN))
+	}
+	if m.Weighting != 0 {
+		n += 1 + sovSnapshot(uint64(m.Weighting))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	if len(m.ExcludeAddresses) > 0 {
+		for _, s := range m.ExcludeAddresses {
+			l = len(s)
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovSnapshot(uint64(m.CampaignId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSnapshot(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovSnapshot(uint64(m.Count))
+	}
+	l = m.Allocated.Size()
+	n += 1 + l + sovSnapshot(uint64(l))
+	return n
+}
+
+func sovSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshot(x uint64) (n int) {
+	return sovSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SnapshotParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= SnapshotType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			m.Weighting = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weighting |= SnapshotWeighting(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeAddresses = append(m.ExcludeAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
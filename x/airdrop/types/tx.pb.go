@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,7 +44,7 @@ func (m *MsgSetAllocation) Reset()         { *m = MsgSetAllocation{} }
 func (m *MsgSetAllocation) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAllocation) ProtoMessage()    {}
 func (*MsgSetAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{0}
+	return fileDescriptor_c9d2d0d9b279be39, []int{0}
 }
 func (m *MsgSetAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -91,7 +95,7 @@ func (m *MsgSetAllocationResponse) Reset()         { *m = MsgSetAllocationRespon
 func (m *MsgSetAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAllocationResponse) ProtoMessage()    {}
 func (*MsgSetAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{1}
+	return fileDescriptor_c9d2d0d9b279be39, []int{1}
 }
 func (m *MsgSetAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,19 +124,164 @@ func (m *MsgSetAllocationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAllocationResponse proto.InternalMessageInfo
 
+// MsgSetAllocations defines an sdk.Msg type that uploads a batch of allocations of a campaign
+type MsgSetAllocations struct {
+	Sender      string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	CampaignId  uint64              `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Allocations []AirdropAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations"`
+	// prev_checksum is the running checksum of the upload before the batch, empty for the first batch
+	PrevChecksum string `protobuf:"bytes,4,opt,name=prev_checksum,json=prevChecksum,proto3" json:"prev_checksum,omitempty"`
+	// checksum is the running checksum of the upload including the batch
+	Checksum string `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// total is the amount of all the allocations of the upload
+	Total github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total"`
+}
+
+func (m *MsgSetAllocations) Reset()         { *m = MsgSetAllocations{} }
+func (m *MsgSetAllocations) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllocations) ProtoMessage()    {}
+func (*MsgSetAllocations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{2}
+}
+func (m *MsgSetAllocations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllocations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllocations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllocations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllocations.Merge(m, src)
+}
+func (m *MsgSetAllocations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllocations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllocations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllocations proto.InternalMessageInfo
+
+func (m *MsgSetAllocations) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetAllocations) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+func (m *MsgSetAllocations) GetAllocations() []AirdropAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *MsgSetAllocations) GetPrevChecksum() string {
+	if m != nil {
+		return m.PrevChecksum
+	}
+	return ""
+}
+
+func (m *MsgSetAllocations) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+// MsgSetAllocationsResponse defines the Msg/SetAllocations response type.
+type MsgSetAllocationsResponse struct {
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgSetAllocationsResponse) Reset()         { *m = MsgSetAllocationsResponse{} }
+func (m *MsgSetAllocationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllocationsResponse) ProtoMessage()    {}
+func (*MsgSetAllocationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{3}
+}
+func (m *MsgSetAllocationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllocationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllocationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllocationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllocationsResponse.Merge(m, src)
+}
+func (m *MsgSetAllocationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllocationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllocationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllocationsResponse proto.InternalMessageInfo
+
+func (m *MsgSetAllocationsResponse) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *MsgSetAllocationsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // MsgClaimAllocation defines an sdk.Msg type that claims airdrop allocation
 type MsgClaimAllocation struct {
 	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	PubKey        string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 	RewardAddress string `protobuf:"bytes,3,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
 	Signature     string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// merkle_claim proves the allocation against a merkle airdrop when it is not stored on-chain
+	MerkleClaim *MerkleClaim `protobuf:"bytes,5,opt,name=merkle_claim,json=merkleClaim,proto3" json:"merkle_claim,omitempty"`
+	// amount is the part of the claimable allocation to claim, the whole claimable amount when empty
+	Amount *github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount,omitempty"`
+	// destinations splits the claimed amount across furya addresses, the reward address receives it all when empty
+	Destinations []ClaimDestination `protobuf:"bytes,7,rep,name=destinations,proto3" json:"destinations"`
+	// validator_address is the validator the reward address delegates delegate_percentage of its payout to
+	ValidatorAddress   string                                 `protobuf:"bytes,8,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	DelegatePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=delegate_percentage,json=delegatePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegate_percentage"`
+	// campaign_id is the campaign of the claimed allocation, the campaign of the merkle airdrop for merkle claims
+	CampaignId uint64 `protobuf:"varint,10,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *MsgClaimAllocation) Reset()         { *m = MsgClaimAllocation{} }
 func (m *MsgClaimAllocation) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimAllocation) ProtoMessage()    {}
 func (*MsgClaimAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{2}
+	return fileDescriptor_c9d2d0d9b279be39, []int{4}
 }
 func (m *MsgClaimAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,6 +310,52 @@ func (m *MsgClaimAllocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimAllocation proto.InternalMessageInfo
 
+// ClaimDestination defines a furya address receiving part of a claim
+type ClaimDestination struct {
+	Address string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *ClaimDestination) Reset()         { *m = ClaimDestination{} }
+func (m *ClaimDestination) String() string { return proto.CompactTextString(m) }
+func (*ClaimDestination) ProtoMessage()    {}
+func (*ClaimDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{5}
+}
+func (m *ClaimDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimDestination.Merge(m, src)
+}
+func (m *ClaimDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimDestination proto.InternalMessageInfo
+
+func (m *ClaimDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // MsgClaimAllocationResponse defines the Msg/ClaimAllocation response type.
 type MsgClaimAllocationResponse struct {
 }
@@ -169,7 +364,7 @@ func (m *MsgClaimAllocationResponse) Reset()         { *m = MsgClaimAllocationRe
 func (m *MsgClaimAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimAllocationResponse) ProtoMessage()    {}
 func (*MsgClaimAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{3}
+	return fileDescriptor_c9d2d0d9b279be39, []int{6}
 }
 func (m *MsgClaimAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +405,7 @@ func (m *MsgSignData) Reset()         { *m = MsgSignData{} }
 func (m *MsgSignData) String() string { return proto.CompactTextString(m) }
 func (*MsgSignData) ProtoMessage()    {}
 func (*MsgSignData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{4}
+	return fileDescriptor_c9d2d0d9b279be39, []int{7}
 }
 func (m *MsgSignData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,6 +448,8 @@ func (m *MsgSignData) GetData() []byte {
 	return nil
 }
 
+// MsgTransferModuleOwnership proposes a new module owner, which takes over once it accepts
+// the ownership. An empty new owner cancels the pending transfer.
 type MsgTransferModuleOwnership struct {
 	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
@@ -262,7 +459,7 @@ func (m *MsgTransferModuleOwnership) Reset()         { *m = MsgTransferModuleOwn
 func (m *MsgTransferModuleOwnership) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferModuleOwnership) ProtoMessage()    {}
 func (*MsgTransferModuleOwnership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{5}
+	return fileDescriptor_c9d2d0d9b279be39, []int{8}
 }
 func (m *MsgTransferModuleOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +509,7 @@ func (m *MsgTransferModuleOwnershipResponse) Reset()         { *m = MsgTransferM
 func (m *MsgTransferModuleOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferModuleOwnershipResponse) ProtoMessage()    {}
 func (*MsgTransferModuleOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{6}
+	return fileDescriptor_c9d2d0d9b279be39, []int{9}
 }
 func (m *MsgTransferModuleOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,16 +538,99 @@ func (m *MsgTransferModuleOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferModuleOwnershipResponse proto.InternalMessageInfo
 
+// MsgAcceptModuleOwnership accepts the module ownership proposed to the sender.
+type MsgAcceptModuleOwnership struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgAcceptModuleOwnership) Reset()         { *m = MsgAcceptModuleOwnership{} }
+func (m *MsgAcceptModuleOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptModuleOwnership) ProtoMessage()    {}
+func (*MsgAcceptModuleOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{10}
+}
+func (m *MsgAcceptModuleOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptModuleOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptModuleOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptModuleOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptModuleOwnership.Merge(m, src)
+}
+func (m *MsgAcceptModuleOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptModuleOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptModuleOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptModuleOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptModuleOwnership) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgAcceptModuleOwnershipResponse struct {
+}
+
+func (m *MsgAcceptModuleOwnershipResponse) Reset()         { *m = MsgAcceptModuleOwnershipResponse{} }
+func (m *MsgAcceptModuleOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptModuleOwnershipResponse) ProtoMessage()    {}
+func (*MsgAcceptModuleOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{11}
+}
+func (m *MsgAcceptModuleOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptModuleOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptModuleOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptModuleOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptModuleOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptModuleOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptModuleOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptModuleOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptModuleOwnershipResponse proto.InternalMessageInfo
+
 type MsgDepositTokens struct {
 	Sender string                                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Amount []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// campaign_id is the campaign to fund, zero for the module owner pool.
+	CampaignId uint64 `protobuf:"varint,3,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
 func (m *MsgDepositTokens) Reset()         { *m = MsgDepositTokens{} }
 func (m *MsgDepositTokens) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositTokens) ProtoMessage()    {}
 func (*MsgDepositTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{7}
+	return fileDescriptor_c9d2d0d9b279be39, []int{12}
 }
 func (m *MsgDepositTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,6 +666,13 @@ func (m *MsgDepositTokens) GetSender() string {
 	return ""
 }
 
+func (m *MsgDepositTokens) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
 type MsgDepositTokensResponse struct {
 }
 
@@ -393,7 +680,7 @@ func (m *MsgDepositTokensResponse) Reset()         { *m = MsgDepositTokensRespon
 func (m *MsgDepositTokensResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositTokensResponse) ProtoMessage()    {}
 func (*MsgDepositTokensResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fbdab318d176f45, []int{8}
+	return fileDescriptor_c9d2d0d9b279be39, []int{13}
 }
 func (m *MsgDepositTokensResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,707 +709,4450 @@ func (m *MsgDepositTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositTokensResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetAllocation)(nil), "furya.airdrop.v1beta1.MsgSetAllocation")
-	proto.RegisterType((*MsgSetAllocationResponse)(nil), "furya.airdrop.v1beta1.MsgSetAllocationResponse")
-	proto.RegisterType((*MsgClaimAllocation)(nil), "furya.airdrop.v1beta1.MsgClaimAllocation")
-	proto.RegisterType((*MsgClaimAllocationResponse)(nil), "furya.airdrop.v1beta1.MsgClaimAllocationResponse")
-	proto.RegisterType((*MsgSignData)(nil), "furya.airdrop.v1beta1.MsgSignData")
-	proto.RegisterType((*MsgTransferModuleOwnership)(nil), "furya.airdrop.v1beta1.MsgTransferModuleOwnership")
-	proto.RegisterType((*MsgTransferModuleOwnershipResponse)(nil), "furya.airdrop.v1beta1.MsgTransferModuleOwnershipResponse")
-	proto.RegisterType((*MsgDepositTokens)(nil), "furya.airdrop.v1beta1.MsgDepositTokens")
-	proto.RegisterType((*MsgDepositTokensResponse)(nil), "furya.airdrop.v1beta1.MsgDepositTokensResponse")
+type MsgCreateMerkleAirdrop struct {
+	Sender      string                                  `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MerkleRoot  string                                  `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_amount"`
+	CampaignId  uint64                                  `protobuf:"varint,4,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("furya/airdrop/v1beta1/tx.proto", fileDescriptor_2fbdab318d176f45) }
-
-var fileDescriptor_2fbdab318d176f45 = []byte{
-	// 611 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6f, 0xd3, 0x3e,
-	0x18, 0x4e, 0xd6, 0xa9, 0x5b, 0xbd, 0xdf, 0x7e, 0xa0, 0x08, 0xb1, 0x10, 0xa6, 0x64, 0x44, 0x20,
-	0xc6, 0xc7, 0x12, 0xad, 0x70, 0x42, 0x5c, 0xd6, 0x0d, 0xa1, 0x09, 0xaa, 0x69, 0xa1, 0x27, 0x2e,
-	0x93, 0xd3, 0x78, 0xa9, 0xd5, 0xd6, 0x8e, 0x6c, 0x87, 0xae, 0x07, 0xee, 0x5c, 0x90, 0x38, 0x71,
-	0xde, 0x9f, 0xb3, 0xe3, 0x8e, 0x88, 0x43, 0x85, 0xda, 0x0b, 0xda, 0x81, 0xbf, 0x01, 0xd5, 0x75,
-	0xfa, 0xb1, 0x91, 0xf2, 0x71, 0xa9, 0xfb, 0xda, 0xcf, 0xfb, 0x3e, 0x8f, 0xdf, 0xf7, 0x89, 0xc1,
-	0x1d, 0x81, 0x18, 0x16, 0x94, 0x61, 0x1f, 0x62, 0x16, 0x31, 0x9a, 0xf8, 0xef, 0xb6, 0x43, 0x24,
-	0xe0, 0xb6, 0x2f, 0x4e, 0xbc, 0x84, 0x51, 0x41, 0x0d, 0x33, 0x83, 0x78, 0x0a, 0xe2, 0x29, 0x88,
-	0x75, 0x23, 0xa6, 0x31, 0x95, 0x20, 0x7f, 0xf8, 0x6f, 0x84, 0xb7, 0x6e, 0xc5, 0x94, 0xc6, 0x2d,
-	0xe4, 0xcb, 0x28, 0x4c, 0x8f, 0x7d, 0x48, 0xba, 0xea, 0xe8, 0x41, 0x2e, 0x1b, 0x6c, 0xb5, 0x68,
-	0x1d, 0x0a, 0x4c, 0xc9, 0x08, 0xea, 0xbe, 0x07, 0xd7, 0xab, 0x3c, 0x7e, 0x83, 0xc4, 0xce, 0xf8,
-	0xc4, 0xb8, 0x09, 0x8a, 0x1c, 0x91, 0x08, 0x31, 0x53, 0xdf, 0xd0, 0x37, 0x4b, 0x81, 0x8a, 0x8c,
-	0x43, 0x00, 0x26, 0xf9, 0xe6, 0xc2, 0x86, 0xbe, 0xb9, 0x52, 0x7e, 0xe4, 0xe5, 0xc9, 0xf6, 0x76,
-	0x46, 0xf1, 0xa4, 0x70, 0x65, 0xf1, 0xac, 0xe7, 0x68, 0xc1, 0x54, 0x11, 0xd7, 0x02, 0xe6, 0x65,
-	0xfa, 0x00, 0xf1, 0x84, 0x12, 0x8e, 0xdc, 0xcf, 0x3a, 0x30, 0xaa, 0x3c, 0xde, 0x6d, 0x41, 0xdc,
-	0x9e, 0x52, 0x67, 0x82, 0x25, 0x18, 0x45, 0x0c, 0x71, 0xae, 0xe4, 0x65, 0xa1, 0xb1, 0x06, 0x96,
-	0x92, 0x34, 0x3c, 0x6a, 0xa2, 0xae, 0x14, 0x57, 0x0a, 0x8a, 0x49, 0x1a, 0xbe, 0x42, 0x5d, 0xe3,
-	0x1e, 0xf8, 0x9f, 0xa1, 0x0e, 0x64, 0xd1, 0x51, 0x96, 0x59, 0x90, 0xe7, 0xab, 0xa3, 0xdd, 0x1d,
-	0x95, 0xbf, 0x0e, 0x4a, 0x1c, 0xc7, 0x04, 0x8a, 0x94, 0x21, 0x73, 0x51, 0x22, 0x26, 0x1b, 0xcf,
-	0x96, 0x3f, 0x9c, 0x3a, 0xda, 0xf7, 0x53, 0x47, 0x73, 0xd7, 0x81, 0x75, 0x55, 0xd7, 0x58, 0xf6,
-	0x01, 0x58, 0x19, 0x5e, 0x09, 0xc7, 0x64, 0x0f, 0x0a, 0x68, 0xb8, 0xa0, 0x38, 0xac, 0x91, 0x35,
-	0xb3, 0x02, 0x2e, 0x7a, 0x8e, 0xda, 0x09, 0xd4, 0x6a, 0xac, 0x83, 0xc5, 0x08, 0x0a, 0x28, 0x55,
-	0xff, 0x57, 0x59, 0xbe, 0xe8, 0x39, 0x32, 0x0e, 0xe4, 0xaf, 0x7b, 0x28, 0xe9, 0x6a, 0x0c, 0x12,
-	0x7e, 0x8c, 0x58, 0x95, 0x46, 0x69, 0x0b, 0x1d, 0x74, 0x08, 0x62, 0xbc, 0x81, 0x93, 0xdc, 0x61,
-	0xdd, 0x06, 0x25, 0x82, 0x3a, 0x47, 0x74, 0x08, 0x54, 0xed, 0x58, 0x26, 0xa8, 0x23, 0x13, 0xdd,
-	0xbb, 0xc0, 0xcd, 0x2f, 0x39, 0xbe, 0x09, 0x97, 0xde, 0xd8, 0x43, 0x09, 0xe5, 0x58, 0xd4, 0x68,
-	0x13, 0x11, 0x9e, 0x4b, 0xf7, 0x12, 0x14, 0x61, 0x9b, 0xa6, 0x44, 0x98, 0x0b, 0x1b, 0x85, 0xcd,
-	0x52, 0xc5, 0x1f, 0x8e, 0xfa, 0x6b, 0xcf, 0xb9, 0x1f, 0x63, 0xd1, 0x48, 0x43, 0xaf, 0x4e, 0xdb,
-	0x7e, 0x9d, 0xf2, 0x36, 0xe5, 0x6a, 0xd9, 0xe2, 0x51, 0xd3, 0x17, 0xdd, 0x04, 0x71, 0x6f, 0x97,
-	0x62, 0x12, 0xa8, 0x74, 0xe5, 0x88, 0x19, 0xd2, 0x4c, 0x50, 0xf9, 0x47, 0x01, 0x14, 0xaa, 0x3c,
-	0x36, 0x52, 0x70, 0xed, 0xb2, 0x2b, 0x1e, 0xe7, 0xfb, 0xf0, 0xea, 0xac, 0xac, 0xa7, 0x7f, 0x83,
-	0xce, 0xe8, 0x0d, 0x0a, 0x56, 0x67, 0x3f, 0x94, 0x87, 0x73, 0xcb, 0xcc, 0x60, 0xad, 0xf2, 0x9f,
-	0x63, 0xc7, 0x84, 0x1f, 0x75, 0xb0, 0x96, 0x37, 0xf7, 0xf9, 0x57, 0xc8, 0xc9, 0xb2, 0x9e, 0xff,
-	0x4b, 0xd6, 0x74, 0x03, 0x66, 0xdd, 0x30, 0xbf, 0x01, 0x33, 0xd8, 0xdf, 0x34, 0xe0, 0x97, 0x03,
-	0xaf, 0xbc, 0x3e, 0xeb, 0xdb, 0xfa, 0x79, 0xdf, 0xd6, 0xbf, 0xf5, 0x6d, 0xfd, 0xd3, 0xc0, 0xd6,
-	0xce, 0x07, 0xb6, 0xf6, 0x65, 0x60, 0x6b, 0x6f, 0xcb, 0x53, 0xbe, 0xaa, 0xbd, 0x08, 0xf6, 0x6b,
-	0x07, 0xc1, 0xbe, 0x9f, 0x11, 0x6c, 0xd5, 0x1b, 0x10, 0x13, 0xff, 0x64, 0xfc, 0xfc, 0x49, 0x9f,
-	0x85, 0x45, 0xf9, 0xe4, 0x3d, 0xf9, 0x19, 0x00, 0x00, 0xff, 0xff, 0x52, 0x43, 0x70, 0x4f, 0x8d,
-	0x05, 0x00, 0x00,
+func (m *MsgCreateMerkleAirdrop) Reset()         { *m = MsgCreateMerkleAirdrop{} }
+func (m *MsgCreateMerkleAirdrop) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleAirdrop) ProtoMessage()    {}
+func (*MsgCreateMerkleAirdrop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{14}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ClaimAllocation defines a method to claim allocation
-	ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error)
-	// SetAllocation defines a method to set allocation
-	SetAllocation(ctx context.Context, in *MsgSetAllocation, opts ...grpc.CallOption) (*MsgSetAllocationResponse, error)
-	// TransferModuleOwnership defines a method to transfer module ownership to other address
-	TransferModuleOwnership(ctx context.Context, in *MsgTransferModuleOwnership, opts ...grpc.CallOption) (*MsgTransferModuleOwnershipResponse, error)
-	// DepositTokens defines a method to deposit tokens to the module
-	DepositTokens(ctx context.Context, in *MsgDepositTokens, opts ...grpc.CallOption) (*MsgDepositTokensResponse, error)
+func (m *MsgCreateMerkleAirdrop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateMerkleAirdrop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMerkleAirdrop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateMerkleAirdrop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleAirdrop.Merge(m, src)
 }
-
-func (c *msgClient) ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error) {
-	out := new(MsgClaimAllocationResponse)
-	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/ClaimAllocation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateMerkleAirdrop) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SetAllocation(ctx context.Context, in *MsgSetAllocation, opts ...grpc.CallOption) (*MsgSetAllocationResponse, error) {
-	out := new(MsgSetAllocationResponse)
-	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/SetAllocation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCreateMerkleAirdrop) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleAirdrop.DiscardUnknown(m)
 }
 
-func (c *msgClient) TransferModuleOwnership(ctx context.Context, in *MsgTransferModuleOwnership, opts ...grpc.CallOption) (*MsgTransferModuleOwnershipResponse, error) {
-	out := new(MsgTransferModuleOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/TransferModuleOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMerkleAirdrop proto.InternalMessageInfo
+
+func (m *MsgCreateMerkleAirdrop) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DepositTokens(ctx context.Context, in *MsgDepositTokens, opts ...grpc.CallOption) (*MsgDepositTokensResponse, error) {
-	out := new(MsgDepositTokensResponse)
-	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/DepositTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCreateMerkleAirdrop) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ClaimAllocation defines a method to claim allocation
-	ClaimAllocation(context.Context, *MsgClaimAllocation) (*MsgClaimAllocationResponse, error)
-	// SetAllocation defines a method to set allocation
-	SetAllocation(context.Context, *MsgSetAllocation) (*MsgSetAllocationResponse, error)
-	// TransferModuleOwnership defines a method to transfer module ownership to other address
-	TransferModuleOwnership(context.Context, *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error)
-	// DepositTokens defines a method to deposit tokens to the module
-	DepositTokens(context.Context, *MsgDepositTokens) (*MsgDepositTokensResponse, error)
+func (m *MsgCreateMerkleAirdrop) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgCreateMerkleAirdropResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (*UnimplementedMsgServer) ClaimAllocation(ctx context.Context, req *MsgClaimAllocation) (*MsgClaimAllocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllocation not implemented")
-}
-func (*UnimplementedMsgServer) SetAllocation(ctx context.Context, req *MsgSetAllocation) (*MsgSetAllocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllocation not implemented")
+func (m *MsgCreateMerkleAirdropResponse) Reset()         { *m = MsgCreateMerkleAirdropResponse{} }
+func (m *MsgCreateMerkleAirdropResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMerkleAirdropResponse) ProtoMessage()    {}
+func (*MsgCreateMerkleAirdropResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{15}
 }
-func (*UnimplementedMsgServer) TransferModuleOwnership(ctx context.Context, req *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferModuleOwnership not implemented")
+func (m *MsgCreateMerkleAirdropResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DepositTokens(ctx context.Context, req *MsgDepositTokens) (*MsgDepositTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositTokens not implemented")
+func (m *MsgCreateMerkleAirdropResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMerkleAirdropResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateMerkleAirdropResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMerkleAirdropResponse.Merge(m, src)
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMerkleAirdropResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMerkleAirdropResponse.DiscardUnknown(m)
 }
 
-func _Msg_ClaimAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimAllocation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimAllocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.airdrop.v1beta1.Msg/ClaimAllocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimAllocation(ctx, req.(*MsgClaimAllocation))
+var xxx_messageInfo_MsgCreateMerkleAirdropResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMerkleAirdropResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAllocation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAllocation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.airdrop.v1beta1.Msg/SetAllocation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAllocation(ctx, req.(*MsgSetAllocation))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCreateCampaign struct {
+	Sender    string        `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom     string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	StartTime time.Time     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time     `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Vesting   VestingParams `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting"`
 }
 
-func _Msg_TransferModuleOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferModuleOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferModuleOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.airdrop.v1beta1.Msg/TransferModuleOwnership",
+func (m *MsgCreateCampaign) Reset()         { *m = MsgCreateCampaign{} }
+func (m *MsgCreateCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaign) ProtoMessage()    {}
+func (*MsgCreateCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{16}
+}
+func (m *MsgCreateCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferModuleOwnership(ctx, req.(*MsgTransferModuleOwnership))
+}
+func (m *MsgCreateCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaign.Merge(m, src)
+}
+func (m *MsgCreateCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCampaign proto.InternalMessageInfo
+
+func (m *MsgCreateCampaign) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DepositTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositTokens)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateCampaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositTokens(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/furya.airdrop.v1beta1.Msg/DepositTokens",
+	return time.Time{}
+}
+
+func (m *MsgCreateCampaign) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositTokens(ctx, req.(*MsgDepositTokens))
+	return time.Time{}
+}
+
+func (m *MsgCreateCampaign) GetVesting() VestingParams {
+	if m != nil {
+		return m.Vesting
 	}
-	return interceptor(ctx, in, info, handler)
+	return VestingParams{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "furya.airdrop.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClaimAllocation",
-			Handler:    _Msg_ClaimAllocation_Handler,
-		},
-		{
-			MethodName: "SetAllocation",
-			Handler:    _Msg_SetAllocation_Handler,
-		},
-		{
-			MethodName: "TransferModuleOwnership",
-			Handler:    _Msg_TransferModuleOwnership_Handler,
-		},
-		{
-			MethodName: "DepositTokens",
-			Handler:    _Msg_DepositTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "furya/airdrop/v1beta1/tx.proto",
+type MsgCreateCampaignResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSetAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateCampaignResponse) Reset()         { *m = MsgCreateCampaignResponse{} }
+func (m *MsgCreateCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaignResponse) ProtoMessage()    {}
+func (*MsgCreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{17}
+}
+func (m *MsgCreateCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCreateCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaignResponse.Merge(m, src)
+}
+func (m *MsgCreateCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaignResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgCreateCampaignResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgSetAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+// MsgRevokeAllocation defines an sdk.Msg type that revokes the unclaimed amount of an allocation
+type MsgRevokeAllocation struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CampaignId uint64 `protobuf:"varint,3,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *MsgRevokeAllocation) Reset()         { *m = MsgRevokeAllocation{} }
+func (m *MsgRevokeAllocation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllocation) ProtoMessage()    {}
+func (*MsgRevokeAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{18}
+}
+func (m *MsgRevokeAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokeAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllocation.Merge(m, src)
+}
+func (m *MsgRevokeAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllocation proto.InternalMessageInfo
+
+func (m *MsgRevokeAllocation) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetAllocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeAllocation) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeAllocation) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
 }
 
-func (m *MsgSetAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevokeAllocationResponse defines the Msg/RevokeAllocation response type.
+type MsgRevokeAllocationResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 }
 
-func (m *MsgClaimAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeAllocationResponse) Reset()         { *m = MsgRevokeAllocationResponse{} }
+func (m *MsgRevokeAllocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllocationResponse) ProtoMessage()    {}
+func (*MsgRevokeAllocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{19}
+}
+func (m *MsgRevokeAllocationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllocationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeAllocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllocationResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllocationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllocationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeAllocationResponse proto.InternalMessageInfo
+
+// MsgWithdrawTokens defines an sdk.Msg type that withdraws unallocated tokens of a campaign
+type MsgWithdrawTokens struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// campaign_id is the campaign to withdraw from, zero for the module owner pool.
+	CampaignId uint64                                    `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Amount     []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// recipient is the address receiving the tokens, the sender if empty.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgClaimAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RewardAddress) > 0 {
-		i -= len(m.RewardAddress)
-		copy(dAtA[i:], m.RewardAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgWithdrawTokens) Reset()         { *m = MsgWithdrawTokens{} }
+func (m *MsgWithdrawTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokens) ProtoMessage()    {}
+func (*MsgWithdrawTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{20}
+}
+func (m *MsgWithdrawTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokens.Merge(m, src)
+}
+func (m *MsgWithdrawTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokens proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokens) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClaimAllocationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawTokens) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawTokens) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgClaimAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgWithdrawTokensResponse defines the Msg/WithdrawTokens response type.
+type MsgWithdrawTokensResponse struct {
 }
 
-func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawTokensResponse) Reset()         { *m = MsgWithdrawTokensResponse{} }
+func (m *MsgWithdrawTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokensResponse) ProtoMessage()    {}
+func (*MsgWithdrawTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{21}
+}
+func (m *MsgWithdrawTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSignData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokensResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokensResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSignData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgWithdrawTokensResponse proto.InternalMessageInfo
+
+// MsgCreateSnapshotCampaign defines an sdk.Msg type that creates a campaign with allocations to a snapshot
+// of furya accounts taken at the current height, its sender must be the governance module account.
+type MsgCreateSnapshotCampaign struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// owner is the owner of the created campaign, which funds it.
+	Owner     string         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	StartTime time.Time      `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time      `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	Vesting   VestingParams  `protobuf:"bytes,5,opt,name=vesting,proto3" json:"vesting"`
+	Snapshot  SnapshotParams `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
 }
 
-func (m *MsgTransferModuleOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSnapshotCampaign) Reset()         { *m = MsgCreateSnapshotCampaign{} }
+func (m *MsgCreateSnapshotCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSnapshotCampaign) ProtoMessage()    {}
+func (*MsgCreateSnapshotCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{22}
+}
+func (m *MsgCreateSnapshotCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSnapshotCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSnapshotCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgTransferModuleOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSnapshotCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSnapshotCampaign.Merge(m, src)
+}
+func (m *MsgCreateSnapshotCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSnapshotCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSnapshotCampaign.DiscardUnknown(m)
 }
 
-func (m *MsgTransferModuleOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgCreateSnapshotCampaign proto.InternalMessageInfo
+
+func (m *MsgCreateSnapshotCampaign) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCreateSnapshotCampaign) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTransferModuleOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSnapshotCampaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgTransferModuleOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSnapshotCampaign) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *MsgTransferModuleOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateSnapshotCampaign) GetVesting() VestingParams {
+	if m != nil {
+		return m.Vesting
+	}
+	return VestingParams{}
 }
 
-func (m *MsgDepositTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSnapshotCampaign) GetSnapshot() SnapshotParams {
+	if m != nil {
+		return m.Snapshot
 	}
-	return dAtA[:n], nil
+	return SnapshotParams{}
 }
 
-func (m *MsgDepositTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateSnapshotCampaignResponse defines the Msg/CreateSnapshotCampaign response type.
+type MsgCreateSnapshotCampaignResponse struct {
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Count      uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *MsgDepositTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Amount[iNdEx].Size()
-				i -= size
-				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCreateSnapshotCampaignResponse) Reset()         { *m = MsgCreateSnapshotCampaignResponse{} }
+func (m *MsgCreateSnapshotCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSnapshotCampaignResponse) ProtoMessage()    {}
+func (*MsgCreateSnapshotCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d2d0d9b279be39, []int{23}
+}
+func (m *MsgCreateSnapshotCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSnapshotCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSnapshotCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCreateSnapshotCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSnapshotCampaignResponse.Merge(m, src)
+}
+func (m *MsgCreateSnapshotCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSnapshotCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSnapshotCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSnapshotCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSnapshotCampaignResponse) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDepositTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSnapshotCampaignResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDepositTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgSetAllocation)(nil), "furya.airdrop.v1beta1.MsgSetAllocation")
+	proto.RegisterType((*MsgSetAllocationResponse)(nil), "furya.airdrop.v1beta1.MsgSetAllocationResponse")
+	proto.RegisterType((*MsgSetAllocations)(nil), "furya.airdrop.v1beta1.MsgSetAllocations")
+	proto.RegisterType((*MsgSetAllocationsResponse)(nil), "furya.airdrop.v1beta1.MsgSetAllocationsResponse")
+	proto.RegisterType((*MsgClaimAllocation)(nil), "furya.airdrop.v1beta1.MsgClaimAllocation")
+	proto.RegisterType((*ClaimDestination)(nil), "furya.airdrop.v1beta1.ClaimDestination")
+	proto.RegisterType((*MsgClaimAllocationResponse)(nil), "furya.airdrop.v1beta1.MsgClaimAllocationResponse")
+	proto.RegisterType((*MsgSignData)(nil), "furya.airdrop.v1beta1.MsgSignData")
+	proto.RegisterType((*MsgTransferModuleOwnership)(nil), "furya.airdrop.v1beta1.MsgTransferModuleOwnership")
+	proto.RegisterType((*MsgTransferModuleOwnershipResponse)(nil), "furya.airdrop.v1beta1.MsgTransferModuleOwnershipResponse")
+	proto.RegisterType((*MsgAcceptModuleOwnership)(nil), "furya.airdrop.v1beta1.MsgAcceptModuleOwnership")
+	proto.RegisterType((*MsgAcceptModuleOwnershipResponse)(nil), "furya.airdrop.v1beta1.MsgAcceptModuleOwnershipResponse")
+	proto.RegisterType((*MsgDepositTokens)(nil), "furya.airdrop.v1beta1.MsgDepositTokens")
+	proto.RegisterType((*MsgDepositTokensResponse)(nil), "furya.airdrop.v1beta1.MsgDepositTokensResponse")
+	proto.RegisterType((*MsgCreateMerkleAirdrop)(nil), "furya.airdrop.v1beta1.MsgCreateMerkleAirdrop")
+	proto.RegisterType((*MsgCreateMerkleAirdropResponse)(nil), "furya.airdrop.v1beta1.MsgCreateMerkleAirdropResponse")
+	proto.RegisterType((*MsgCreateCampaign)(nil), "furya.airdrop.v1beta1.MsgCreateCampaign")
+	proto.RegisterType((*MsgCreateCampaignResponse)(nil), "furya.airdrop.v1beta1.MsgCreateCampaignResponse")
+	proto.RegisterType((*MsgRevokeAllocation)(nil), "furya.airdrop.v1beta1.MsgRevokeAllocation")
+	proto.RegisterType((*MsgRevokeAllocationResponse)(nil), "furya.airdrop.v1beta1.MsgRevokeAllocationResponse")
+	proto.RegisterType((*MsgWithdrawTokens)(nil), "furya.airdrop.v1beta1.MsgWithdrawTokens")
+	proto.RegisterType((*MsgWithdrawTokensResponse)(nil), "furya.airdrop.v1beta1.MsgWithdrawTokensResponse")
+	proto.RegisterType((*MsgCreateSnapshotCampaign)(nil), "furya.airdrop.v1beta1.MsgCreateSnapshotCampaign")
+	proto.RegisterType((*MsgCreateSnapshotCampaignResponse)(nil), "furya.airdrop.v1beta1.MsgCreateSnapshotCampaignResponse")
 }
 
-func (m *MsgDepositTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("furya/airdrop/v1beta1/tx.proto", fileDescriptor_c9d2d0d9b279be39) }
+
+var fileDescriptor_c9d2d0d9b279be39 = []byte{
+	// 1340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0xda, 0x4e, 0x62, 0x7f, 0x4e, 0x4b, 0xba, 0x7d, 0x39, 0xdb, 0xc8, 0x0e, 0x4b, 0x1f,
+	0x81, 0xaa, 0xde, 0xd4, 0x08, 0xf1, 0xb8, 0xa0, 0x3c, 0xaa, 0xaa, 0x42, 0xa6, 0xed, 0xb6, 0x02,
+	0xa9, 0x17, 0x6b, 0xb2, 0x3b, 0x59, 0xaf, 0x62, 0xef, 0xac, 0x66, 0xc6, 0x49, 0x03, 0x27, 0x24,
+	0x24, 0xb8, 0x20, 0xf5, 0xc0, 0x91, 0x43, 0x0f, 0x88, 0x7f, 0x80, 0xff, 0x01, 0xf5, 0xd8, 0x23,
+	0xe2, 0x10, 0x50, 0x7b, 0x41, 0xfd, 0x2b, 0xd0, 0xce, 0xce, 0x8e, 0xed, 0xb5, 0xd7, 0xb1, 0x9b,
+	0x1b, 0x97, 0xd8, 0x33, 0xf3, 0xfb, 0x9e, 0xf3, 0x9b, 0xef, 0xfb, 0x1c, 0xa8, 0xee, 0xf5, 0xe8,
+	0x11, 0xb2, 0x90, 0x4f, 0x5d, 0x4a, 0x42, 0xeb, 0xe0, 0xf6, 0x2e, 0xe6, 0xe8, 0xb6, 0xc5, 0x9f,
+	0xd6, 0x43, 0x4a, 0x38, 0xd1, 0x2f, 0x8a, 0xf3, 0xba, 0x3c, 0xaf, 0xcb, 0x73, 0xe3, 0x82, 0x47,
+	0x3c, 0x22, 0x10, 0x56, 0xf4, 0x2d, 0x06, 0x1b, 0x2b, 0x1e, 0x21, 0x5e, 0x07, 0x5b, 0x62, 0xb5,
+	0xdb, 0xdb, 0xb3, 0x50, 0x70, 0x24, 0x8f, 0x6a, 0xe9, 0x23, 0xee, 0x77, 0x31, 0xe3, 0xa8, 0x1b,
+	0x4a, 0xc0, 0xf5, 0xf1, 0x8e, 0xa0, 0x4e, 0x87, 0x38, 0x88, 0xfb, 0x24, 0x90, 0xb8, 0xab, 0xe3,
+	0x71, 0x0e, 0xea, 0x86, 0xc8, 0xf7, 0x12, 0x94, 0x39, 0x1e, 0xd5, 0xc5, 0x74, 0xbf, 0x83, 0x27,
+	0x6b, 0x62, 0x01, 0x0a, 0x59, 0x9b, 0xf0, 0x18, 0x65, 0x7e, 0x03, 0xcb, 0x4d, 0xe6, 0x3d, 0xc2,
+	0x7c, 0x53, 0x79, 0xa2, 0x5f, 0x82, 0x05, 0x86, 0x03, 0x17, 0xd3, 0x8a, 0xb6, 0xa6, 0xad, 0x97,
+	0x6c, 0xb9, 0xd2, 0xbf, 0x04, 0xe8, 0xfb, 0x5b, 0xc9, 0xad, 0x69, 0xeb, 0xe5, 0xc6, 0x7a, 0x7d,
+	0x6c, 0x06, 0xeb, 0x9b, 0xf1, 0xba, 0xaf, 0x75, 0xab, 0xf0, 0xe2, 0xb8, 0x36, 0x67, 0x0f, 0x68,
+	0x30, 0x0d, 0xa8, 0xa4, 0x6d, 0xdb, 0x98, 0x85, 0x24, 0x60, 0xd8, 0xfc, 0x2d, 0x07, 0xe7, 0xd2,
+	0x87, 0x2c, 0xd3, 0xb3, 0x1a, 0x94, 0x93, 0x0c, 0xb5, 0x7c, 0x57, 0xb8, 0x56, 0xb0, 0x21, 0xd9,
+	0xba, 0xe7, 0xea, 0x0f, 0xa0, 0xdc, 0x37, 0xcc, 0x2a, 0xf9, 0xb5, 0xfc, 0x5b, 0xf8, 0x3e, 0xa8,
+	0x42, 0x7f, 0x0f, 0xce, 0x84, 0x14, 0x1f, 0xb4, 0x9c, 0x36, 0x76, 0xf6, 0x59, 0xaf, 0x5b, 0x29,
+	0x08, 0x8f, 0x96, 0xa2, 0xcd, 0x6d, 0xb9, 0xa7, 0x1b, 0x50, 0x54, 0xe7, 0xf3, 0xe2, 0x5c, 0xad,
+	0xf5, 0x3b, 0x30, 0xcf, 0x09, 0x47, 0x9d, 0xca, 0x42, 0x74, 0xb0, 0x65, 0x45, 0x26, 0xfe, 0x3a,
+	0xae, 0xdd, 0xf0, 0x7c, 0xde, 0xee, 0xed, 0xd6, 0x1d, 0xd2, 0xb5, 0x1c, 0xc2, 0xba, 0x84, 0xc9,
+	0x8f, 0x5b, 0xcc, 0xdd, 0xb7, 0xf8, 0x51, 0x88, 0x59, 0x7d, 0x9b, 0xf8, 0x81, 0x1d, 0x4b, 0x9b,
+	0x4d, 0x58, 0x19, 0xc9, 0x53, 0x92, 0xc5, 0x21, 0xfb, 0x5a, 0xca, 0xfe, 0x05, 0x98, 0x77, 0x48,
+	0x2f, 0xe0, 0x32, 0x5b, 0xf1, 0xc2, 0xfc, 0xb5, 0x00, 0x7a, 0x93, 0x79, 0xdb, 0x1d, 0xe4, 0x77,
+	0x07, 0x28, 0x51, 0x81, 0x45, 0xe4, 0xba, 0x14, 0x33, 0x26, 0xf5, 0x24, 0x4b, 0xfd, 0x32, 0x2c,
+	0x86, 0xbd, 0xdd, 0xd6, 0x3e, 0x3e, 0x12, 0x8a, 0x4a, 0xf6, 0x42, 0xd8, 0xdb, 0xfd, 0x02, 0x1f,
+	0xe9, 0xd7, 0xe0, 0x2c, 0xc5, 0x87, 0x88, 0xba, 0xad, 0x44, 0x32, 0x2f, 0xce, 0xcf, 0xc4, 0xbb,
+	0x9b, 0x52, 0x7e, 0x15, 0x4a, 0xcc, 0xf7, 0x02, 0xc4, 0x7b, 0x14, 0xcb, 0x1c, 0xf6, 0x37, 0xf4,
+	0x3b, 0xb0, 0x14, 0x93, 0xba, 0xe5, 0x44, 0x1e, 0x89, 0x24, 0x96, 0x1b, 0x66, 0xc6, 0xc5, 0x35,
+	0x05, 0x54, 0xf8, 0x6e, 0x97, 0xbb, 0xfd, 0x85, 0xbe, 0x0d, 0x0b, 0xa8, 0x2b, 0x82, 0x8d, 0x93,
+	0x7d, 0x73, 0x96, 0x44, 0x4b, 0x51, 0xfd, 0x21, 0x2c, 0xb9, 0x98, 0x71, 0x3f, 0x90, 0x24, 0x5a,
+	0x14, 0x24, 0xba, 0x91, 0xe1, 0x8b, 0x30, 0xbc, 0xd3, 0xc7, 0x4b, 0x0e, 0x0d, 0xa9, 0xd0, 0x6f,
+	0xc2, 0xb9, 0x03, 0xd4, 0xf1, 0x5d, 0xc4, 0x09, 0x55, 0x69, 0x2a, 0x8a, 0x24, 0x2c, 0xab, 0x83,
+	0x24, 0x53, 0x2d, 0x38, 0xef, 0xe2, 0x0e, 0xf6, 0x10, 0xc7, 0xad, 0x10, 0x53, 0x07, 0x07, 0x1c,
+	0x79, 0xb8, 0x52, 0x12, 0x11, 0xd5, 0x25, 0x7d, 0xae, 0x4f, 0x11, 0xd5, 0x0e, 0x76, 0x6c, 0x3d,
+	0x51, 0xf5, 0x40, 0x69, 0x4a, 0xbf, 0x22, 0x48, 0xbf, 0xa2, 0xcf, 0x8a, 0x3f, 0x3e, 0xaf, 0xcd,
+	0xfd, 0xfb, 0xbc, 0x36, 0x67, 0xf6, 0x60, 0x39, 0x1d, 0xe0, 0x04, 0x8e, 0xdc, 0x55, 0xe9, 0xcf,
+	0xbd, 0x1d, 0xd7, 0xa5, 0xb8, 0xb9, 0x0a, 0xc6, 0x28, 0x39, 0x55, 0xcd, 0xb8, 0x0f, 0xe5, 0xe8,
+	0x29, 0xf8, 0x5e, 0xb0, 0x83, 0x38, 0xd2, 0x4d, 0x58, 0x88, 0x88, 0x94, 0x14, 0x8b, 0x2d, 0x78,
+	0x73, 0x5c, 0x93, 0x3b, 0xb6, 0xfc, 0xd4, 0x57, 0xa1, 0xe0, 0x22, 0x8e, 0x84, 0x5f, 0x4b, 0x5b,
+	0xc5, 0x37, 0xc7, 0x35, 0xb1, 0xb6, 0xc5, 0x5f, 0xf3, 0xa1, 0x30, 0xf7, 0x98, 0xa2, 0x80, 0xed,
+	0x61, 0xda, 0x24, 0x6e, 0xaf, 0x83, 0xef, 0x1f, 0x06, 0x98, 0xb2, 0xb6, 0x1f, 0x66, 0x16, 0xa3,
+	0x2b, 0x50, 0x0a, 0xf0, 0x61, 0x8b, 0x44, 0x40, 0xf9, 0x26, 0x8a, 0x01, 0x3e, 0x14, 0x82, 0xe6,
+	0x55, 0x30, 0xb3, 0x55, 0xaa, 0x48, 0x1a, 0xa2, 0x32, 0x6e, 0x3a, 0x0e, 0x0e, 0xf9, 0x94, 0x66,
+	0x4d, 0x13, 0xd6, 0xb2, 0x64, 0x94, 0xde, 0x9f, 0x35, 0x51, 0xee, 0x77, 0x70, 0x48, 0x98, 0xcf,
+	0x1f, 0x93, 0x7d, 0x3c, 0xa1, 0xa8, 0x0e, 0xde, 0x5a, 0xfe, 0x14, 0xb7, 0x96, 0xe6, 0x55, 0x3e,
+	0xcd, 0x2b, 0xd9, 0x08, 0x86, 0xbc, 0x52, 0x2e, 0xff, 0xa1, 0xc1, 0xa5, 0xe8, 0xce, 0x29, 0x46,
+	0x1c, 0xc7, 0x0f, 0x5c, 0xd6, 0xe7, 0x49, 0xdd, 0x40, 0x16, 0x0d, 0x4a, 0x88, 0xe4, 0x9c, 0x0d,
+	0xf1, 0x96, 0x4d, 0x08, 0xd7, 0x6d, 0x58, 0x12, 0xc5, 0xb3, 0x25, 0xe3, 0xcb, 0xbf, 0x1d, 0x2b,
+	0xcb, 0x42, 0xc9, 0xe6, 0xd8, 0x20, 0x0b, 0x23, 0x41, 0x6e, 0x40, 0x75, 0x7c, 0x1c, 0xaa, 0x5a,
+	0x9f, 0x85, 0x9c, 0xef, 0x8a, 0x58, 0x0a, 0x76, 0xce, 0x77, 0xcd, 0x9f, 0xe2, 0x1e, 0x18, 0x8b,
+	0x6c, 0x4b, 0x4d, 0x99, 0x51, 0x5f, 0x80, 0x79, 0x17, 0x07, 0xa4, 0x2b, 0xe3, 0x8d, 0x17, 0xfa,
+	0x36, 0x00, 0xe3, 0x88, 0xf2, 0x56, 0x34, 0x90, 0x88, 0x40, 0xcb, 0x0d, 0xa3, 0x1e, 0x4f, 0x2b,
+	0xf5, 0x64, 0x5a, 0xa9, 0x3f, 0x4e, 0xa6, 0x95, 0xad, 0x62, 0x94, 0x84, 0x67, 0x7f, 0xd7, 0x34,
+	0xbb, 0x24, 0xe4, 0xa2, 0x13, 0xfd, 0x73, 0x28, 0xe2, 0xc0, 0x8d, 0x55, 0x14, 0x66, 0x50, 0xb1,
+	0x88, 0x03, 0x57, 0x28, 0xd8, 0x81, 0xc5, 0x03, 0x51, 0x29, 0x3c, 0x59, 0xc1, 0xaf, 0x66, 0x54,
+	0xcd, 0xaf, 0x62, 0xd4, 0x03, 0x44, 0x51, 0x97, 0xc9, 0x92, 0x99, 0x88, 0x9a, 0x37, 0x61, 0x65,
+	0x24, 0x1d, 0x99, 0xc9, 0x6b, 0xc3, 0xf9, 0x26, 0xf3, 0x6c, 0x7c, 0x40, 0xf6, 0xf1, 0x14, 0xb3,
+	0xcd, 0x40, 0xf1, 0xca, 0x0d, 0x17, 0xaf, 0x13, 0xd9, 0xbb, 0x07, 0x57, 0xc6, 0x58, 0x52, 0x8e,
+	0xf5, 0x9f, 0x91, 0x76, 0xba, 0xe2, 0xf7, 0xbb, 0x26, 0xe8, 0xf0, 0xb5, 0xcf, 0xdb, 0x2e, 0x45,
+	0x87, 0x27, 0xbc, 0xde, 0x13, 0x47, 0xa2, 0xbe, 0x5f, 0xf9, 0xd3, 0x3d, 0xef, 0x55, 0x28, 0x51,
+	0xec, 0xf8, 0xa1, 0x8f, 0x03, 0x9e, 0x74, 0x70, 0xb5, 0x61, 0x5e, 0x81, 0x95, 0x11, 0xa7, 0xd5,
+	0xe3, 0x3e, 0xce, 0x0d, 0x5c, 0xe9, 0x23, 0x39, 0x99, 0x4e, 0xc3, 0xf4, 0xc1, 0xe2, 0x1a, 0x2f,
+	0xfe, 0x4f, 0x4c, 0xd7, 0xef, 0x42, 0x31, 0x99, 0xd3, 0xc5, 0xc4, 0x52, 0x6e, 0x5c, 0xcb, 0x50,
+	0x93, 0x24, 0x6d, 0x48, 0x8f, 0x12, 0x36, 0x9f, 0xc0, 0xbb, 0x99, 0xf9, 0x55, 0x0c, 0x4d, 0x51,
+	0x45, 0x1b, 0xa1, 0xca, 0xd8, 0x51, 0xb1, 0xf1, 0x0b, 0x40, 0xbe, 0xc9, 0x3c, 0x9d, 0xc0, 0x3b,
+	0xe9, 0x71, 0xf1, 0xfd, 0xac, 0x01, 0x6d, 0xa4, 0x79, 0x1b, 0xb7, 0xa7, 0x86, 0x2a, 0x7f, 0x7d,
+	0x38, 0x33, 0xfc, 0x83, 0xe5, 0x46, 0xb6, 0x8e, 0x21, 0xa0, 0x61, 0x4d, 0x09, 0x54, 0xa6, 0x3a,
+	0x70, 0x36, 0xf5, 0x13, 0x64, 0x7d, 0x4a, 0x15, 0xcc, 0xd8, 0x98, 0x16, 0xa9, 0xac, 0xfd, 0xa0,
+	0xc1, 0xe5, 0xac, 0x69, 0x63, 0x42, 0x9e, 0x32, 0x44, 0x8c, 0x4f, 0x67, 0x16, 0x51, 0x9e, 0x7c,
+	0xa7, 0xc1, 0xc5, 0xf1, 0xe3, 0xc7, 0x84, 0x14, 0x8e, 0x15, 0x30, 0x3e, 0x9e, 0x51, 0x60, 0xf0,
+	0x9a, 0x87, 0x07, 0x95, 0x09, 0xd7, 0x3c, 0x04, 0x34, 0xac, 0x29, 0x81, 0xca, 0xd4, 0xb7, 0x70,
+	0x7e, 0xdc, 0x80, 0x71, 0x6b, 0x02, 0x37, 0x47, 0xe1, 0xc6, 0x47, 0x33, 0xc1, 0x07, 0x39, 0x96,
+	0x6a, 0xf1, 0xeb, 0x27, 0x29, 0x4a, 0x90, 0xc6, 0xc6, 0xb4, 0x48, 0x65, 0x8d, 0xc2, 0xf2, 0x48,
+	0x53, 0xfc, 0x20, 0x5b, 0x4b, 0x1a, 0x6b, 0x34, 0xa6, 0xc7, 0x0e, 0x46, 0x98, 0xea, 0x5a, 0x13,
+	0x22, 0x1c, 0x46, 0x1a, 0x1b, 0xd3, 0x22, 0x95, 0xb5, 0xef, 0x35, 0xb8, 0x94, 0xd1, 0x51, 0x4e,
+	0x4c, 0x57, 0x5a, 0xc2, 0xf8, 0x64, 0x56, 0x89, 0xc4, 0x8d, 0xad, 0x7b, 0x2f, 0x5e, 0x55, 0xb5,
+	0x97, 0xaf, 0xaa, 0xda, 0x3f, 0xaf, 0xaa, 0xda, 0xb3, 0xd7, 0xd5, 0xb9, 0x97, 0xaf, 0xab, 0x73,
+	0x7f, 0xbe, 0xae, 0xce, 0x3d, 0xb1, 0x06, 0x3a, 0x6c, 0xa4, 0x9d, 0x85, 0x84, 0x72, 0xf1, 0xed,
+	0x96, 0xd3, 0x46, 0x7e, 0x60, 0x3d, 0x55, 0xff, 0xb5, 0x11, 0xed, 0x76, 0x77, 0x41, 0xf4, 0x9e,
+	0x0f, 0xff, 0x1b, 0x00, 0x6b, 0xf5, 0x3c, 0x42, 0xce, 0x12, 0x00, 0x00,
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ClaimAllocation defines a method to claim allocation
+	ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error)
+	// SetAllocation defines a method to set allocation
+	SetAllocation(ctx context.Context, in *MsgSetAllocation, opts ...grpc.CallOption) (*MsgSetAllocationResponse, error)
+	// SetAllocations defines a method to upload a batch of allocations of a campaign
+	SetAllocations(ctx context.Context, in *MsgSetAllocations, opts ...grpc.CallOption) (*MsgSetAllocationsResponse, error)
+	// TransferModuleOwnership defines a method to propose a new module owner
+	TransferModuleOwnership(ctx context.Context, in *MsgTransferModuleOwnership, opts ...grpc.CallOption) (*MsgTransferModuleOwnershipResponse, error)
+	// AcceptModuleOwnership defines a method for the proposed module owner to accept the ownership
+	AcceptModuleOwnership(ctx context.Context, in *MsgAcceptModuleOwnership, opts ...grpc.CallOption) (*MsgAcceptModuleOwnershipResponse, error)
+	// DepositTokens defines a method to deposit tokens to the module
+	DepositTokens(ctx context.Context, in *MsgDepositTokens, opts ...grpc.CallOption) (*MsgDepositTokensResponse, error)
+	// CreateMerkleAirdrop defines a method to register a merkle root of allocations
+	CreateMerkleAirdrop(ctx context.Context, in *MsgCreateMerkleAirdrop, opts ...grpc.CallOption) (*MsgCreateMerkleAirdropResponse, error)
+	// CreateCampaign defines a method to create an airdrop campaign owned by the sender
+	CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error)
+	// RevokeAllocation defines a method to revoke the unclaimed amount of an allocation
+	RevokeAllocation(ctx context.Context, in *MsgRevokeAllocation, opts ...grpc.CallOption) (*MsgRevokeAllocationResponse, error)
+	// WithdrawTokens defines a method to withdraw the unallocated balance of a campaign
+	WithdrawTokens(ctx context.Context, in *MsgWithdrawTokens, opts ...grpc.CallOption) (*MsgWithdrawTokensResponse, error)
+	// CreateSnapshotCampaign defines a governance method to create a campaign allocating to a snapshot of furya accounts
+	CreateSnapshotCampaign(ctx context.Context, in *MsgCreateSnapshotCampaign, opts ...grpc.CallOption) (*MsgCreateSnapshotCampaignResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ClaimAllocation(ctx context.Context, in *MsgClaimAllocation, opts ...grpc.CallOption) (*MsgClaimAllocationResponse, error) {
+	out := new(MsgClaimAllocationResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/ClaimAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgSetAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) SetAllocation(ctx context.Context, in *MsgSetAllocation, opts ...grpc.CallOption) (*MsgSetAllocationResponse, error) {
+	out := new(MsgSetAllocationResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/SetAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetAllocations(ctx context.Context, in *MsgSetAllocations, opts ...grpc.CallOption) (*MsgSetAllocationsResponse, error) {
+	out := new(MsgSetAllocationsResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/SetAllocations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Allocation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgSetAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) TransferModuleOwnership(ctx context.Context, in *MsgTransferModuleOwnership, opts ...grpc.CallOption) (*MsgTransferModuleOwnershipResponse, error) {
+	out := new(MsgTransferModuleOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/TransferModuleOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AcceptModuleOwnership(ctx context.Context, in *MsgAcceptModuleOwnership, opts ...grpc.CallOption) (*MsgAcceptModuleOwnershipResponse, error) {
+	out := new(MsgAcceptModuleOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/AcceptModuleOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DepositTokens(ctx context.Context, in *MsgDepositTokens, opts ...grpc.CallOption) (*MsgDepositTokensResponse, error) {
+	out := new(MsgDepositTokensResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/DepositTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateMerkleAirdrop(ctx context.Context, in *MsgCreateMerkleAirdrop, opts ...grpc.CallOption) (*MsgCreateMerkleAirdropResponse, error) {
+	out := new(MsgCreateMerkleAirdropResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/CreateMerkleAirdrop", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error) {
+	out := new(MsgCreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/CreateCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeAllocation(ctx context.Context, in *MsgRevokeAllocation, opts ...grpc.CallOption) (*MsgRevokeAllocationResponse, error) {
+	out := new(MsgRevokeAllocationResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/RevokeAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClaimAllocationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawTokens(ctx context.Context, in *MsgWithdrawTokens, opts ...grpc.CallOption) (*MsgWithdrawTokensResponse, error) {
+	out := new(MsgWithdrawTokensResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/WithdrawTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSignData) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateSnapshotCampaign(ctx context.Context, in *MsgCreateSnapshotCampaign, opts ...grpc.CallOption) (*MsgCreateSnapshotCampaignResponse, error) {
+	out := new(MsgCreateSnapshotCampaignResponse)
+	err := c.cc.Invoke(ctx, "/furya.airdrop.v1beta1.Msg/CreateSnapshotCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ClaimAllocation defines a method to claim allocation
+	ClaimAllocation(context.Context, *MsgClaimAllocation) (*MsgClaimAllocationResponse, error)
+	// SetAllocation defines a method to set allocation
+	SetAllocation(context.Context, *MsgSetAllocation) (*MsgSetAllocationResponse, error)
+	// SetAllocations defines a method to upload a batch of allocations of a campaign
+	SetAllocations(context.Context, *MsgSetAllocations) (*MsgSetAllocationsResponse, error)
+	// TransferModuleOwnership defines a method to propose a new module owner
+	TransferModuleOwnership(context.Context, *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error)
+	// AcceptModuleOwnership defines a method for the proposed module owner to accept the ownership
+	AcceptModuleOwnership(context.Context, *MsgAcceptModuleOwnership) (*MsgAcceptModuleOwnershipResponse, error)
+	// DepositTokens defines a method to deposit tokens to the module
+	DepositTokens(context.Context, *MsgDepositTokens) (*MsgDepositTokensResponse, error)
+	// CreateMerkleAirdrop defines a method to register a merkle root of allocations
+	CreateMerkleAirdrop(context.Context, *MsgCreateMerkleAirdrop) (*MsgCreateMerkleAirdropResponse, error)
+	// CreateCampaign defines a method to create an airdrop campaign owned by the sender
+	CreateCampaign(context.Context, *MsgCreateCampaign) (*MsgCreateCampaignResponse, error)
+	// RevokeAllocation defines a method to revoke the unclaimed amount of an allocation
+	RevokeAllocation(context.Context, *MsgRevokeAllocation) (*MsgRevokeAllocationResponse, error)
+	// WithdrawTokens defines a method to withdraw the unallocated balance of a campaign
+	WithdrawTokens(context.Context, *MsgWithdrawTokens) (*MsgWithdrawTokensResponse, error)
+	// CreateSnapshotCampaign defines a governance method to create a campaign allocating to a snapshot of furya accounts
+	CreateSnapshotCampaign(context.Context, *MsgCreateSnapshotCampaign) (*MsgCreateSnapshotCampaignResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ClaimAllocation(ctx context.Context, req *MsgClaimAllocation) (*MsgClaimAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllocation not implemented")
+}
+func (*UnimplementedMsgServer) SetAllocation(ctx context.Context, req *MsgSetAllocation) (*MsgSetAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllocation not implemented")
+}
+func (*UnimplementedMsgServer) SetAllocations(ctx context.Context, req *MsgSetAllocations) (*MsgSetAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllocations not implemented")
+}
+func (*UnimplementedMsgServer) TransferModuleOwnership(ctx context.Context, req *MsgTransferModuleOwnership) (*MsgTransferModuleOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferModuleOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptModuleOwnership(ctx context.Context, req *MsgAcceptModuleOwnership) (*MsgAcceptModuleOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptModuleOwnership not implemented")
+}
+func (*UnimplementedMsgServer) DepositTokens(ctx context.Context, req *MsgDepositTokens) (*MsgDepositTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositTokens not implemented")
+}
+func (*UnimplementedMsgServer) CreateMerkleAirdrop(ctx context.Context, req *MsgCreateMerkleAirdrop) (*MsgCreateMerkleAirdropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerkleAirdrop not implemented")
+}
+func (*UnimplementedMsgServer) CreateCampaign(ctx context.Context, req *MsgCreateCampaign) (*MsgCreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAllocation(ctx context.Context, req *MsgRevokeAllocation) (*MsgRevokeAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllocation not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawTokens(ctx context.Context, req *MsgWithdrawTokens) (*MsgWithdrawTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokens not implemented")
+}
+func (*UnimplementedMsgServer) CreateSnapshotCampaign(ctx context.Context, req *MsgCreateSnapshotCampaign) (*MsgCreateSnapshotCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshotCampaign not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ClaimAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllocation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/ClaimAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllocation(ctx, req.(*MsgClaimAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferModuleOwnership) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllocation(ctx, in)
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/SetAllocation",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllocation(ctx, req.(*MsgSetAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTransferModuleOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllocations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/SetAllocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllocations(ctx, req.(*MsgSetAllocations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDepositTokens) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferModuleOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferModuleOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
+	if interceptor == nil {
+		return srv.(MsgServer).TransferModuleOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/TransferModuleOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferModuleOwnership(ctx, req.(*MsgTransferModuleOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptModuleOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptModuleOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptModuleOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/AcceptModuleOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptModuleOwnership(ctx, req.(*MsgAcceptModuleOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/DepositTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositTokens(ctx, req.(*MsgDepositTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMerkleAirdrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMerkleAirdrop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMerkleAirdrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/CreateMerkleAirdrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMerkleAirdrop(ctx, req.(*MsgCreateMerkleAirdrop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/CreateCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCampaign(ctx, req.(*MsgCreateCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAllocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/RevokeAllocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAllocation(ctx, req.(*MsgRevokeAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/WithdrawTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokens(ctx, req.(*MsgWithdrawTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSnapshotCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSnapshotCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSnapshotCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/furya.airdrop.v1beta1.Msg/CreateSnapshotCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSnapshotCampaign(ctx, req.(*MsgCreateSnapshotCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "furya.airdrop.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClaimAllocation",
+			Handler:    _Msg_ClaimAllocation_Handler,
+		},
+		{
+			MethodName: "SetAllocation",
+			Handler:    _Msg_SetAllocation_Handler,
+		},
+		{
+			MethodName: "SetAllocations",
+			Handler:    _Msg_SetAllocations_Handler,
+		},
+		{
+			MethodName: "TransferModuleOwnership",
+			Handler:    _Msg_TransferModuleOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptModuleOwnership",
+			Handler:    _Msg_AcceptModuleOwnership_Handler,
+		},
+		{
+			MethodName: "DepositTokens",
+			Handler:    _Msg_DepositTokens_Handler,
+		},
+		{
+			MethodName: "CreateMerkleAirdrop",
+			Handler:    _Msg_CreateMerkleAirdrop_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _Msg_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "RevokeAllocation",
+			Handler:    _Msg_RevokeAllocation_Handler,
+		},
+		{
+			MethodName: "WithdrawTokens",
+			Handler:    _Msg_WithdrawTokens_Handler,
+		},
+		{
+			MethodName: "CreateSnapshotCampaign",
+			Handler:    _Msg_CreateSnapshotCampaign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "furya/airdrop/v1beta1/tx.proto",
+}
+
+func (m *MsgSetAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PrevChecksum) > 0 {
+		i -= len(m.PrevChecksum)
+		copy(dAtA[i:], m.PrevChecksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevChecksum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllocationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllocationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllocationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.DelegatePercentage.Size()
+		i -= size
+		if _, err := m.DelegatePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Amount != nil {
+		{
+			size := m.Amount.Size()
+			i -= size
+			if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MerkleClaim != nil {
+		{
+			size, err := m.MerkleClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferModuleOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferModuleOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferModuleOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferModuleOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferModuleOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferModuleOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptModuleOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptModuleOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptModuleOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptModuleOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptModuleOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptModuleOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleAirdrop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleAirdrop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleAirdrop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMerkleAirdropResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMerkleAirdropResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMerkleAirdropResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Amount[iNdEx].Size()
+				i -= size
+				if _, err := m.Amount[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSnapshotCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSnapshotCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSnapshotCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSnapshotCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSnapshotCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSnapshotCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAllocations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.PrevChecksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAllocationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MsgClaimAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MerkleClaim != nil {
+		l = m.MerkleClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DelegatePercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *ClaimDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSignData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferModuleOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferModuleOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptModuleOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptModuleOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgDepositTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMerkleAirdrop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgCreateMerkleAirdropResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCreateCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSnapshotCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Vesting.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Snapshot.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateSnapshotCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, AirdropAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllocationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllocationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllocationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MerkleClaim == nil {
+				m.MerkleClaim = &MerkleClaim{}
+			}
+			if err := m.MerkleClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ClaimDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptModuleOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleAirdrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleAirdrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleAirdrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMerkleAirdropResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMerkleAirdropResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMerkleAirdropResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgDepositTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1145,10 +5175,10 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCampaign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1185,7 +5215,39 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1212,7 +5274,73 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1237,7 +5365,7 @@ func (m *MsgSetAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1260,12 +5388,31 @@ func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1287,7 +5434,7 @@ func (m *MsgSetAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1310,15 +5457,15 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1346,11 +5493,11 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1378,45 +5525,13 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
 			}
-			var stringLen uint64
+			m.CampaignId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1426,24 +5541,11 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CampaignId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1465,7 +5567,7 @@ func (m *MsgClaimAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAllocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,12 +5590,46 @@ func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimAllocationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAllocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAllocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1515,7 +5651,7 @@ func (m *MsgClaimAllocationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSignData) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,15 +5674,15 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSignData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSignData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1574,13 +5710,13 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
 			}
-			var byteLen int
+			m.CampaignId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1590,79 +5726,14 @@ func (m *MsgSignData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CampaignId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnership: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,11 +5761,15 @@ func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Coin
+			m.Amount = append(m.Amount, v)
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1722,7 +5797,7 @@ func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1745,7 +5820,7 @@ func (m *MsgTransferModuleOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,10 +5843,10 @@ func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferModuleOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1795,7 +5870,7 @@ func (m *MsgTransferModuleOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSnapshotCampaign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1818,10 +5893,10 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSnapshotCampaign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSnapshotCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1858,7 +5933,7 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1886,9 +5961,137 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Coin
-			m.Amount = append(m.Amount, v)
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1913,7 +6116,7 @@ func (m *MsgDepositTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSnapshotCampaignResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,12 +6139,50 @@ func (m *MsgDepositTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSnapshotCampaignResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSnapshotCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
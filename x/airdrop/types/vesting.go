@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Validate checks the vesting params are consistent with the vesting type
+func (p VestingParams) Validate() error {
+	switch p.Type {
+	case VestingNone:
+		return nil
+	case VestingContinuous:
+		if p.Duration <= 0 {
+			return fmt.Errorf("continuous vesting duration must be positive: %s", p.Duration)
+		}
+	case VestingPeriodic:
+		if p.Duration <= 0 {
+			return fmt.Errorf("periodic vesting duration must be positive: %s", p.Duration)
+		}
+		if p.NumPeriods == 0 || int64(p.Duration.Seconds()) < int64(p.NumPeriods) {
+			return fmt.Errorf("invalid number of vesting periods: %d", p.NumPeriods)
+		}
+	default:
+		return fmt.Errorf("unknown vesting type: %d", p.Type)
+	}
+	return nil
+}
+
+// Periods splits the coins into the equal vesting periods of the params,
+// the last period vesting the remainder.
+func (p VestingParams) Periods(coins sdk.Coins) vestingtypes.Periods {
+	numPeriods := int64(p.NumPeriods)
+	length := int64(p.Duration.Seconds()) / numPeriods
+
+	periods := vestingtypes.Periods{}
+	vested := sdk.NewCoins()
+	for i := int64(0); i < numPeriods; i++ {
+		amount := sdk.NewCoins()
+		for _, coin := range coins {
+			amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods)))
+		}
+		if i == numPeriods-1 {
+			amount = coins.Sub(vested)
+		}
+		vested = vested.Add(amount...)
+		periods = append(periods, vestingtypes.Period{Length: length, Amount: amount})
+	}
+	return periods
+}
@@ -7,6 +7,10 @@ import (
 const (
 	// The connection end identifier on the controller chain
 	FlagConnectionID = "connection-id"
+	// The timeout of the packet relative to the block time
+	FlagTimeout = "timeout"
+	// The memo of the interchain account packet
+	FlagMemo = "memo"
 )
 
 // common flagsets to add to various functions
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/furysport/fury-chain/x/intertx/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -18,6 +20,10 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(getInterchainAccountCmd())
+	cmd.AddCommand(getOwnerInterchainAccountsCmd())
+	cmd.AddCommand(getPacketResultsCmd())
+	cmd.AddCommand(getPacketResultCmd())
+	cmd.AddCommand(getParamsCmd())
 
 	return cmd
 }
@@ -46,3 +52,136 @@ func getInterchainAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+func getOwnerInterchainAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-accounts [owner-account]",
+		Short: "Query the interchain accounts of an owner on all connections with their channel status",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterchainAccounts(cmd.Context(), &types.QueryInterchainAccountsRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner-accounts")
+
+	return cmd
+}
+
+func getPacketResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-results [owner-account] [connection-id]",
+		Short: "Query the results of the transactions submitted by an owner on a connection",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketResults(cmd.Context(), &types.QueryPacketResultsRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet-results")
+
+	return cmd
+}
+
+func getPacketResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-result [owner-account] [connection-id] [channel-id] [sequence]",
+		Short: "Query the result of the transaction submitted in a packet",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketResult(cmd.Context(), &types.QueryPacketResultRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				ChannelId:    args[2],
+				Sequence:     sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the inter-tx module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 
@@ -65,7 +67,10 @@ func getRegisterAccountCmd() *cobra.Command {
 
 func getSubmitTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:  "submit [path/to/sdk_msg.json]",
+		Use:   "submit [path/to/sdk_msgs.json]",
+		Short: "Submit a transaction of one or more messages to the interchain account",
+		Long: `Submit a transaction to the interchain account on the host chain of the connection. The messages are
+given as the JSON of a message, or a JSON array of messages, inline or in a file, and are executed atomically.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -75,25 +80,38 @@ func getSubmitTxCmd() *cobra.Command {
 
 			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
 
-			var txMsg sdk.Msg
-			if err := cdc.UnmarshalInterfaceJSON([]byte(args[0]), &txMsg); err != nil {
-
-				// check for file path if JSON input is not provided
-				contents, err := ioutil.ReadFile(args[0])
+			// check for file path if JSON input is not provided
+			bz := []byte(args[0])
+			if !json.Valid(bz) {
+				bz, err = ioutil.ReadFile(args[0])
 				if err != nil {
-					return errors.Wrap(err, "neither JSON input nor path to .json file for sdk msg were provided")
+					return errors.Wrap(err, "neither JSON input nor path to .json file for sdk msgs were provided")
 				}
+			}
 
-				if err := cdc.UnmarshalInterfaceJSON(contents, &txMsg); err != nil {
-					return errors.Wrap(err, "error unmarshalling sdk msg file")
-				}
+			txMsgs, err := parseTxMsgs(cdc, bz)
+			if err != nil {
+				return errors.Wrap(err, "error unmarshalling sdk msgs")
+			}
+
+			msg, err := types.NewMsgSubmitTx(txMsgs, viper.GetString(FlagConnectionID), clientCtx.GetFromAddress().String())
+			if err != nil {
+				return err
 			}
 
-			msg, err := types.NewMsgSubmitTx(txMsg, viper.GetString(FlagConnectionID), clientCtx.GetFromAddress().String())
+			msg.Memo, err = cmd.Flags().GetString(FlagMemo)
 			if err != nil {
 				return err
 			}
 
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+			if timeout != 0 {
+				msg.Timeout = &timeout
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -104,8 +122,29 @@ func getSubmitTxCmd() *cobra.Command {
 
 	cmd.Flags().AddFlagSet(fsConnectionID)
 	_ = cmd.MarkFlagRequired(FlagConnectionID)
+	cmd.Flags().Duration(FlagTimeout, 0, "Timeout of the packet relative to the block time, the default timeout of the module params if not set")
+	cmd.Flags().String(FlagMemo, "", "Memo of the interchain account packet")
 
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseTxMsgs unmarshals the JSON of a message or of a JSON array of messages
+func parseTxMsgs(cdc *codec.ProtoCodec, bz []byte) ([]sdk.Msg, error) {
+	rawMsgs := []json.RawMessage{bz}
+	if trimmed := bytes.TrimSpace(bz); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &rawMsgs); err != nil {
+			return nil, err
+		}
+	}
+
+	txMsgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &txMsgs[i]); err != nil {
+			return nil, errors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return txMsgs, nil
+}
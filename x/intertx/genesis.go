@@ -0,0 +1,37 @@
+package intertx
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/intertx/keeper"
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// InitGenesis initializes the intertx module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, account := range genState.Accounts {
+		k.SetInterchainAccount(ctx, account)
+	}
+	for _, entry := range genState.ReopenQueue {
+		k.SetReopenQueueEntry(ctx, entry)
+	}
+	for _, result := range genState.PacketResults {
+		k.SetPacketResult(ctx, result)
+	}
+	for _, entry := range genState.PruneQueue {
+		k.SetPruneQueueEntry(ctx, entry)
+	}
+}
+
+// ExportGenesis returns the intertx module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		Accounts:      k.GetAllInterchainAccounts(ctx),
+		ReopenQueue:   k.GetReopenQueue(ctx),
+		PacketResults: k.GetAllPacketResults(ctx),
+		PruneQueue:    k.GetPruneQueue(ctx),
+	}
+}
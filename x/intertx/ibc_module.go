@@ -40,7 +40,12 @@ func (im IBCModule) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	return im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return err
+	}
+
+	im.keeper.OnAccountChannelOpenInit(ctx, connectionHops[0], portID, channelID)
+	return nil
 }
 
 // OnChanOpenTry implements the IBCModule interface
@@ -65,6 +70,7 @@ func (im IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
+	im.keeper.OnAccountChannelOpenAck(ctx, portID, channelID)
 	return nil
 }
 
@@ -86,7 +92,8 @@ func (im IBCModule) OnChanCloseInit(
 	return nil
 }
 
-// OnChanCloseConfirm implements the IBCModule interface
+// OnChanCloseConfirm implements the IBCModule interface. The interchain accounts controller does not
+// forward channel closures, account channels are only closed by packet timeouts.
 func (im IBCModule) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
@@ -118,11 +125,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
 	}
 
+	if !ack.Success() {
+		im.keeper.OnPacketError(ctx, packet, ack.GetError())
+		return nil
+	}
+
 	txMsgData := &sdk.TxMsgData{}
 	if err := proto.Unmarshal(ack.GetResult(), txMsgData); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %v", err)
 	}
 
+	im.keeper.OnPacketSuccess(ctx, packet, txMsgData.Data)
+
 	switch len(txMsgData.Data) {
 	case 0:
 		// TODO: handle for sdk 0.46.x
@@ -140,12 +154,15 @@ func (im IBCModule) OnAcknowledgementPacket(
 	}
 }
 
-// OnTimeoutPacket implements the IBCModule interface.
+// OnTimeoutPacket implements the IBCModule interface. A timeout closes the ordered channel of the
+// account, which is re-opened on the same port at the end of the block.
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	im.keeper.OnPacketTimeout(ctx, packet)
+	im.keeper.OnAccountChannelClosed(ctx, packet.SourcePort, packet.SourceChannel)
 	return nil
 }
 
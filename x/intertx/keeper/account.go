@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// GetInterchainAccount returns the interchain account of an owner on a connection
+func (k Keeper) GetInterchainAccount(ctx sdk.Context, owner, connectionID string) (types.InterchainAccount, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AccountKey(owner, connectionID))
+	if bz == nil {
+		return types.InterchainAccount{}, false
+	}
+
+	account := types.InterchainAccount{}
+	k.cdc.MustUnmarshal(bz, &account)
+	return account, true
+}
+
+// SetInterchainAccount stores the interchain account of an owner on a connection
+func (k Keeper) SetInterchainAccount(ctx sdk.Context, account types.InterchainAccount) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AccountKey(account.Owner, account.ConnectionId), k.cdc.MustMarshal(&account))
+}
+
+// GetOwnerInterchainAccounts returns the interchain accounts of an owner on all connections
+func (k Keeper) GetOwnerInterchainAccounts(ctx sdk.Context, owner string) []types.InterchainAccount {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerAccountsKey(owner))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	accounts := []types.InterchainAccount{}
+	for ; iterator.Valid(); iterator.Next() {
+		account := types.InterchainAccount{}
+		k.cdc.MustUnmarshal(iterator.Value(), &account)
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// GetAllInterchainAccounts returns the interchain accounts of all owners
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []types.InterchainAccount {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	accounts := []types.InterchainAccount{}
+	for ; iterator.Valid(); iterator.Next() {
+		account := types.InterchainAccount{}
+		k.cdc.MustUnmarshal(iterator.Value(), &account)
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// SetReopenQueueEntry queues the interchain account of an owner on a connection to be re-opened at the end of the block
+func (k Keeper) SetReopenQueueEntry(ctx sdk.Context, entry types.ReopenQueueEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReopenQueueKey(entry.Owner, entry.ConnectionId), []byte{})
+}
+
+// GetReopenQueue returns the interchain accounts queued to be re-opened
+func (k Keeper) GetReopenQueue(ctx sdk.Context) []types.ReopenQueueEntry {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ReopenQueueKeyPrefix)
+	defer iterator.Close()
+
+	entries := []types.ReopenQueueEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		owner, connectionID := types.SplitReopenQueueKey(iterator.Key())
+		entries = append(entries, types.ReopenQueueEntry{Owner: owner, ConnectionId: connectionID})
+	}
+	return entries
+}
+
+// getChannelInterchainAccount returns the interchain account of the controller port whose last channel is channelID
+func (k Keeper) getChannelInterchainAccount(ctx sdk.Context, portID, channelID string) (types.InterchainAccount, bool) {
+	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
+		return types.InterchainAccount{}, false
+	}
+
+	for _, account := range k.GetOwnerInterchainAccounts(ctx, strings.TrimPrefix(portID, icatypes.PortPrefix)) {
+		if account.PortId == portID && account.ChannelId == channelID {
+			return account, true
+		}
+	}
+	return types.InterchainAccount{}, false
+}
+
+// OnAccountChannelOpenInit tracks the account of a channel opened on a controller port as pending
+func (k Keeper) OnAccountChannelOpenInit(ctx sdk.Context, connectionID, portID, channelID string) {
+	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
+		return
+	}
+
+	owner := strings.TrimPrefix(portID, icatypes.PortPrefix)
+	account, found := k.GetInterchainAccount(ctx, owner, connectionID)
+	if !found {
+		account = types.InterchainAccount{
+			Owner:        owner,
+			ConnectionId: connectionID,
+			PortId:       portID,
+		}
+	}
+	account.ChannelId = channelID
+	account.Status = types.AccountPending
+	k.SetInterchainAccount(ctx, account)
+}
+
+// OnAccountChannelOpenAck marks the account of a channel as active once the handshake completed
+func (k Keeper) OnAccountChannelOpenAck(ctx sdk.Context, portID, channelID string) {
+	account, found := k.getChannelInterchainAccount(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	account.Address, _ = k.icaControllerKeeper.GetInterchainAccountAddress(ctx, account.ConnectionId, portID)
+	account.Status = types.AccountActive
+	k.SetInterchainAccount(ctx, account)
+}
+
+// OnAccountChannelClosed marks the account of a closed channel as closed and queues it to be re-opened at the
+// end of the block, once the channel is closed in the channel store
+func (k Keeper) OnAccountChannelClosed(ctx sdk.Context, portID, channelID string) {
+	account, found := k.getChannelInterchainAccount(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	account.Status = types.AccountClosed
+	k.SetInterchainAccount(ctx, account)
+	k.SetReopenQueueEntry(ctx, types.ReopenQueueEntry{Owner: account.Owner, ConnectionId: account.ConnectionId})
+}
+
+// ReopenClosedAccounts re-opens a channel on the same port for the accounts closed in the block. An account
+// whose channel cannot be re-opened stays closed and can be re-opened with MsgRegisterAccount.
+func (k Keeper) ReopenClosedAccounts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReopenQueueKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append(types.ReopenQueueKeyPrefix, iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		ctx.KVStore(k.storeKey).Delete(key)
+
+		owner, connectionID := types.SplitReopenQueueKey(key)
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.icaControllerKeeper.RegisterInterchainAccount(cacheCtx, connectionID, owner); err != nil {
+			k.Logger(ctx).Error("failed to re-open interchain account channel", "owner", owner, "connection", connectionID, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/furysport/fury-chain/x/intertx"
+	"github.com/furysport/fury-chain/x/intertx/keeper"
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// openAccountChannel completes the handshake of the channel opened by the controller for the owner
+func (suite *KeeperTestSuite) openAccountChannel(path *ibctesting.Path, channelID string) {
+	path.EndpointA.ChannelID = channelID
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+	path.EndpointB.ChannelID = ""
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+}
+
+// setupAccount registers the interchain account of the test owner and opens its channel
+func (suite *KeeperTestSuite) setupAccount(path *ibctesting.Path) {
+	msgSrv := keeper.NewMsgServerImpl(suite.GetICAApp(suite.chainA).InterTxKeeper)
+	_, err := msgSrv.RegisterAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgRegisterAccount(TestOwnerAddress, path.EndpointA.ConnectionID))
+	suite.Require().NoError(err)
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	suite.openAccountChannel(path, "channel-0")
+}
+
+func (suite *KeeperTestSuite) TestInterchainAccountLifecycle() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	icaApp := suite.GetICAApp(suite.chainA)
+	k := icaApp.InterTxKeeper
+
+	// registering the account opens a pending channel
+	msgSrv := keeper.NewMsgServerImpl(k)
+	_, err := msgSrv.RegisterAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgRegisterAccount(TestOwnerAddress, path.EndpointA.ConnectionID))
+	suite.Require().NoError(err)
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	account, found := k.GetInterchainAccount(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.InterchainAccount{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		PortId:       TestPortID,
+		ChannelId:    "channel-0",
+		Status:       types.AccountPending,
+	}, account)
+
+	// the account is active once the handshake completed
+	suite.openAccountChannel(path, "channel-0")
+	account, _ = k.GetInterchainAccount(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().Equal(types.AccountActive, account.Status)
+	suite.Require().NotEmpty(account.Address)
+	address := account.Address
+
+	// a timed out packet closes the channel, which is re-opened on the same port at the end of the block
+	ctx := suite.chainA.GetContext()
+	chanCap, found := icaApp.ScopedInterTxKeeper.GetCapability(ctx, host.ChannelCapabilityPath(TestPortID, "channel-0"))
+	suite.Require().True(found)
+	data, err := icatypes.SerializeCosmosTx(icaApp.AppCodec(), []sdk.Msg{
+		banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(address), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+	})
+	suite.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Minute).UnixNano())
+	sequence, err := icaApp.ICAControllerKeeper.SendTx(ctx, chanCap, path.EndpointA.ConnectionID, TestPortID, packetData, timeoutTimestamp)
+	suite.Require().NoError(err)
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, TestPortID, "channel-0", icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	proof, proofHeight := path.EndpointB.QueryProof(host.NextSequenceRecvKey(icatypes.PortID, path.EndpointB.ChannelID))
+	nextSeqRecv, found := suite.GetICAApp(suite.chainB).IBCKeeper.ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), icatypes.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	_, err = suite.chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	ctx = suite.chainA.GetContext()
+	channel, _ := icaApp.IBCKeeper.ChannelKeeper.GetChannel(ctx, TestPortID, "channel-0")
+	suite.Require().Equal(channeltypes.CLOSED, channel.State)
+	channel, found = icaApp.IBCKeeper.ChannelKeeper.GetChannel(ctx, TestPortID, "channel-1")
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.INIT, channel.State)
+	account, _ = k.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().Equal(types.AccountPending, account.Status)
+	suite.Require().Equal("channel-1", account.ChannelId)
+
+	// the re-opened channel controls the same account once the relayer closed the host end of the channel
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	proof, proofHeight = path.EndpointA.QueryProof(host.ChannelKey(TestPortID, "channel-0"))
+	_, err = suite.chainB.SendMsgs(channeltypes.NewMsgChannelCloseConfirm(icatypes.PortID, path.EndpointB.ChannelID, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.openAccountChannel(path, "channel-1")
+	account, _ = k.GetInterchainAccount(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().Equal(types.AccountActive, account.Status)
+	suite.Require().Equal(address, account.Address)
+
+	res, err := k.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryInterchainAccountsRequest{Owner: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InterchainAccount{account}, res.Accounts)
+}
+
+func (suite *KeeperTestSuite) TestReopenClosedAccountsFailure() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	k := suite.GetICAApp(suite.chainA).InterTxKeeper
+	ctx := suite.chainA.GetContext()
+
+	// an account whose channel is still open after a timeout is not re-opened at the end of the block and stays closed
+	icaApp := suite.GetICAApp(suite.chainA)
+	icaApp.ICAControllerKeeper.SetActiveChannelID(ctx, path.EndpointA.ConnectionID, TestPortID, "channel-0")
+	icaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, TestPortID, "channel-0", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(icatypes.PortID, "channel-0"),
+		[]string{path.EndpointA.ConnectionID}, TestVersion,
+	))
+	k.OnAccountChannelOpenInit(ctx, path.EndpointA.ConnectionID, TestPortID, "channel-0")
+	cbs, ok := icaApp.IBCKeeper.Router.GetRoute(icacontrollertypes.SubModuleName)
+	suite.Require().True(ok)
+	packet := channeltypes.NewPacket(nil, 1, TestPortID, "channel-0", icatypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+	suite.Require().NoError(cbs.OnTimeoutPacket(ctx, packet, suite.chainA.SenderAccount.GetAddress()))
+	intertx.NewAppModule(icaApp.AppCodec(), k).EndBlock(ctx, abci.RequestEndBlock{})
+
+	account, found := k.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.AccountClosed, account.Status)
+	suite.Require().Equal("channel-0", account.ChannelId)
+
+	// channels of other ports are not tracked
+	k.OnAccountChannelOpenInit(ctx, path.EndpointA.ConnectionID, "transfer", "channel-1")
+	suite.Require().Len(k.GetOwnerInterchainAccounts(ctx, TestOwnerAddress), 1)
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	icaApp := suite.GetICAApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	icaApp.InterTxKeeper.SetParams(ctx, types.NewParams(time.Minute, time.Minute, time.Minute))
+
+	icaApp.ICAControllerKeeper.SetActiveChannelID(ctx, path.EndpointA.ConnectionID, TestPortID, "channel-0")
+	icaApp.ICAControllerKeeper.SetInterchainAccountAddress(ctx, path.EndpointA.ConnectionID, TestPortID, TestAccAddress.String())
+	icaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, TestPortID, "channel-0", channeltypes.NewChannel(
+		channeltypes.CLOSED, channeltypes.ORDERED, channeltypes.NewCounterparty(icatypes.PortID, "channel-0"),
+		[]string{path.EndpointA.ConnectionID}, TestVersion,
+	))
+
+	suite.Require().NoError(keeper.NewMigrator(icaApp.InterTxKeeper).Migrate1to2(ctx))
+	account, found := icaApp.InterTxKeeper.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.InterchainAccount{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		PortId:       TestPortID,
+		ChannelId:    "channel-0",
+		Address:      TestAccAddress.String(),
+		Status:       types.AccountClosed,
+	}, account)
+	suite.Require().Equal(types.DefaultParams(), icaApp.InterTxKeeper.GetParams(ctx))
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+
+	"github.com/furysport/fury-chain/x/intertx"
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	k := suite.GetICAApp(suite.chainA).InterTxKeeper
+	ctx := suite.chainA.GetContext()
+
+	k.SetInterchainAccount(ctx, types.InterchainAccount{
+		Owner:        TestOwnerAddress,
+		ConnectionId: ibctesting.FirstConnectionID,
+		PortId:       TestPortID,
+		ChannelId:    ibctesting.FirstChannelID,
+		Address:      TestAccAddress.String(),
+		Status:       types.AccountActive,
+	})
+	k.OnAccountChannelClosed(ctx, TestPortID, ibctesting.FirstChannelID)
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:        TestOwnerAddress,
+		ConnectionId: ibctesting.FirstConnectionID,
+		ChannelId:    ibctesting.FirstChannelID,
+		Sequence:     1,
+		Status:       types.PacketPending,
+	})
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:          TestOwnerAddress,
+		ConnectionId:   ibctesting.FirstConnectionID,
+		ChannelId:      ibctesting.FirstChannelID,
+		Sequence:       2,
+		Status:         types.PacketTimeout,
+		CompletionTime: ctx.BlockTime().UTC(),
+	})
+
+	genState := intertx.ExportGenesis(ctx, k)
+	suite.Require().NoError(genState.Validate())
+	suite.Require().Len(genState.Accounts, 1)
+	suite.Require().Equal([]types.ReopenQueueEntry{{Owner: TestOwnerAddress, ConnectionId: ibctesting.FirstConnectionID}}, genState.ReopenQueue)
+	suite.Require().Len(genState.PacketResults, 2)
+	suite.Require().Equal([]types.PruneQueueEntry{{
+		CompletionTime: ctx.BlockTime().UTC(),
+		Owner:          TestOwnerAddress,
+		ConnectionId:   ibctesting.FirstConnectionID,
+		ChannelId:      ibctesting.FirstChannelID,
+		Sequence:       2,
+	}}, genState.PruneQueue)
+
+	// the exported state is imported as is on another chain
+	importedK := suite.GetICAApp(suite.chainB).InterTxKeeper
+	importedCtx := suite.chainB.GetContext()
+	intertx.InitGenesis(importedCtx, importedK, *genState)
+	suite.Require().Equal(genState, intertx.ExportGenesis(importedCtx, importedK))
+
+	// a completed packet result must be queued to be pruned
+	invalid := *genState
+	invalid.PruneQueue = nil
+	suite.Require().Error(invalid.Validate())
+
+	// a queued account must be closed
+	invalid = *genState
+	invalid.Accounts = []types.InterchainAccount{genState.Accounts[0]}
+	invalid.Accounts[0].Status = types.AccountActive
+	suite.Require().Error(invalid.Validate())
+
+	// an account must be on the controller port of its owner
+	invalid.Accounts[0].Status = types.AccountClosed
+	invalid.Accounts[0].PortId = icatypes.PortPrefix + "other"
+	suite.Require().Error(invalid.Validate())
+}
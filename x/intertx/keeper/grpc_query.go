@@ -6,7 +6,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 
 	"github.com/furysport/fury-chain/x/intertx/types"
@@ -28,3 +30,94 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 
 	return types.NewQueryInterchainAccountResponse(addr), nil
 }
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(goCtx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accountStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerAccountsKey(req.Owner))
+
+	accounts := []types.InterchainAccount{}
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
+		account := types.InterchainAccount{}
+		if err := k.cdc.Unmarshal(value, &account); err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PacketResults implements the Query/PacketResults gRPC method
+func (k Keeper) PacketResults(goCtx context.Context, req *types.QueryPacketResultsRequest) (*types.QueryPacketResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner == "" || req.ConnectionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner and connection id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resultStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConnectionPacketResultsKey(req.Owner, req.ConnectionId))
+
+	results := []types.PacketResult{}
+	pageRes, err := query.Paginate(resultStore, req.Pagination, func(key []byte, value []byte) error {
+		result := types.PacketResult{}
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPacketResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PacketResult implements the Query/PacketResult gRPC method
+func (k Keeper) PacketResult(goCtx context.Context, req *types.QueryPacketResultRequest) (*types.QueryPacketResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	result, found := k.GetPacketResult(ctx, req.Owner, req.ConnectionId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no result found for packet %d of channel %s", req.Sequence, req.ChannelId)
+	}
+
+	return &types.QueryPacketResultResponse{
+		Result: result,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 tracks the interchain accounts registered before their status was tracked and sets the default
+// params, which define the timeouts of the submitted transactions and the result retention. Accounts whose
+// channel is already closed are not re-opened, their owner can re-open them with MsgRegisterAccount.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+	for _, activeChannel := range k.icaControllerKeeper.GetAllActiveChannels(ctx) {
+		if !strings.HasPrefix(activeChannel.PortId, icatypes.PortPrefix) {
+			continue
+		}
+
+		account := types.InterchainAccount{
+			Owner:        strings.TrimPrefix(activeChannel.PortId, icatypes.PortPrefix),
+			ConnectionId: activeChannel.ConnectionId,
+			PortId:       activeChannel.PortId,
+			ChannelId:    activeChannel.ChannelId,
+			Status:       types.AccountClosed,
+		}
+		account.Address, _ = k.icaControllerKeeper.GetInterchainAccountAddress(ctx, activeChannel.ConnectionId, activeChannel.PortId)
+		if _, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, activeChannel.ConnectionId, activeChannel.PortId); found {
+			account.Status = types.AccountActive
+		}
+		k.SetInterchainAccount(ctx, account)
+	}
+
+	k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"github.com/furysport/fury-chain/x/intertx/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,7 +52,13 @@ func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*typ
 		return nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	data, err := icatypes.SerializeCosmosTx(k.cdc, []sdk.Msg{msg.GetTxMsg()})
+	txMsgs, err := msg.GetTxMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	// the host chain executes the messages of the CosmosTx atomically
+	data, err := icatypes.SerializeCosmosTx(k.cdc, txMsgs)
 	if err != nil {
 		return nil, err
 	}
@@ -61,15 +66,38 @@ func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*typ
 	packetData := icatypes.InterchainAccountPacketData{
 		Type: icatypes.EXECUTE_TX,
 		Data: data,
+		Memo: msg.Memo,
+	}
+
+	// a timed out packet closes the ordered channel of the account, so the timeout has to leave the
+	// relayers enough time to relay the packet
+	params := k.GetParams(ctx)
+	timeout := params.DefaultTimeout
+	if msg.Timeout != nil {
+		timeout = *msg.Timeout
+	}
+	if timeout > params.MaxTimeout {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTimeout, "timeout %s exceeds the max timeout %s", timeout, params.MaxTimeout)
 	}
 
-	// timeoutTimestamp set to max value with the unsigned bit shifted to sastisfy hermes timestamp conversion
-	// it is the responsibility of the auth module developer to ensure an appropriate timeout timestamp
-	timeoutTimestamp := ctx.BlockTime().Add(time.Minute).UnixNano()
-	_, err = k.icaControllerKeeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, packetData, uint64(timeoutTimestamp))
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, packetData, timeoutTimestamp)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSubmitTxResponse{}, nil
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:            msg.Owner,
+		ConnectionId:     msg.ConnectionId,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Status:           types.PacketPending,
+		TimeoutTimestamp: timeoutTimestamp,
+		SubmitHeight:     ctx.BlockHeight(),
+	})
+
+	return &types.MsgSubmitTxResponse{
+		Sequence:         sequence,
+		TimeoutTimestamp: timeoutTimestamp,
+	}, nil
 }
@@ -1,8 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 
@@ -88,3 +93,106 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitTx() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.setupAccount(path)
+
+	icaApp := suite.GetICAApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	msgSrv := keeper.NewMsgServerImpl(icaApp.InterTxKeeper)
+	address, _ := icaApp.InterTxKeeper.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	sendMsg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(address.Address), suite.chainB.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	// transactions without a timeout time out after the default timeout
+	msg, err := types.NewMsgSubmitTx([]sdk.Msg{sendMsg}, path.EndpointA.ConnectionID, TestOwnerAddress)
+	suite.Require().NoError(err)
+	res, err := msgSrv.SubmitTx(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Sequence)
+	suite.Require().Equal(uint64(ctx.BlockTime().Add(types.DefaultParams().DefaultTimeout).UnixNano()), res.TimeoutTimestamp)
+
+	timeout := time.Hour
+	msg.Timeout = &timeout
+	res, err = msgSrv.SubmitTx(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Sequence)
+	suite.Require().Equal(uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), res.TimeoutTimestamp)
+
+	// the timeout is bounded by the max timeout of the params
+	icaApp.InterTxKeeper.SetParams(ctx, types.NewParams(time.Minute, 30*time.Minute, time.Hour))
+	_, err = msgSrv.SubmitTx(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidTimeout)
+	msg.Timeout = nil
+	res, err = msgSrv.SubmitTx(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(ctx.BlockTime().Add(time.Minute).UnixNano()), res.TimeoutTimestamp)
+}
+
+func (suite *KeeperTestSuite) TestSubmitTxBatch() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.setupAccount(path)
+
+	icaApp := suite.GetICAApp(suite.chainA)
+	hostApp := suite.GetICAApp(suite.chainB)
+	account, _ := icaApp.InterTxKeeper.GetInterchainAccount(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID)
+	icaAddr := sdk.MustAccAddressFromBech32(account.Address)
+	recipient1 := sdk.AccAddress("recipient1__________")
+	recipient2 := sdk.AccAddress("recipient2__________")
+
+	// the host chain allows bank sends of interchain accounts, which is funded
+	hostApp.ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+	_, err := suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	// submitTx submits the messages and relays their packet, it returns whether the host chain executed them
+	submitTx := func(msgs ...sdk.Msg) bool {
+		msg, err := types.NewMsgSubmitTx(msgs, path.EndpointA.ConnectionID, TestOwnerAddress)
+		suite.Require().NoError(err)
+		msg.Memo = "batch"
+		suite.Require().NoError(msg.ValidateBasic())
+
+		res, err := keeper.NewMsgServerImpl(icaApp.InterTxKeeper).SubmitTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+		suite.Require().NoError(err)
+		suite.chainA.App.Commit()
+		suite.chainA.NextBlock()
+		suite.Require().NoError(path.EndpointB.UpdateClient())
+
+		data, err := icatypes.SerializeCosmosTx(icaApp.AppCodec(), msgs)
+		suite.Require().NoError(err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data, Memo: "batch"}
+		packet := channeltypes.NewPacket(packetData.GetBytes(), res.Sequence, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), res.TimeoutTimestamp)
+		recvRes, err := path.EndpointB.RecvPacketWithResult(packet)
+		suite.Require().NoError(err)
+
+		bz, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+		suite.Require().NoError(err)
+		var ack channeltypes.Acknowledgement
+		suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+		return ack.Success()
+	}
+	balance := func(addr sdk.AccAddress) int64 {
+		return hostApp.BankKeeper.GetBalance(suite.chainB.GetContext(), addr, "stake").Amount.Int64()
+	}
+
+	// all the messages of a batch are executed
+	suite.Require().True(submitTx(
+		banktypes.NewMsgSend(icaAddr, recipient1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		banktypes.NewMsgSend(icaAddr, recipient2, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+	))
+	suite.Require().Equal(int64(700), balance(icaAddr))
+	suite.Require().Equal(int64(100), balance(recipient1))
+	suite.Require().Equal(int64(200), balance(recipient2))
+
+	// or none of them when one fails
+	suite.Require().False(submitTx(
+		banktypes.NewMsgSend(icaAddr, recipient1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		banktypes.NewMsgSend(icaAddr, recipient2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))),
+	))
+	suite.Require().Equal(int64(700), balance(icaAddr))
+	suite.Require().Equal(int64(100), balance(recipient1))
+	suite.Require().Equal(int64(200), balance(recipient2))
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// MaxPrunedResultsPerBlock bounds the number of expired packet results deleted at the end of a block
+const MaxPrunedResultsPerBlock = 100
+
+// GetPacketResult returns the result of a packet sent on a channel of the interchain account of an owner
+func (k Keeper) GetPacketResult(ctx sdk.Context, owner, connectionID, channelID string, sequence uint64) (types.PacketResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketResultKey(owner, connectionID, channelID, sequence))
+	if bz == nil {
+		return types.PacketResult{}, false
+	}
+
+	result := types.PacketResult{}
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// SetPacketResult stores the result of a packet, completed results are queued to be pruned once the
+// result retention of the params elapsed
+func (k Keeper) SetPacketResult(ctx sdk.Context, result types.PacketResult) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PacketResultKey(result.Owner, result.ConnectionId, result.ChannelId, result.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&result))
+
+	if result.Status != types.PacketPending {
+		k.SetPruneQueueEntry(ctx, types.PruneQueueEntry{
+			CompletionTime: result.CompletionTime,
+			Owner:          result.Owner,
+			ConnectionId:   result.ConnectionId,
+			ChannelId:      result.ChannelId,
+			Sequence:       result.Sequence,
+		})
+	}
+}
+
+// GetConnectionPacketResults returns the results of the packets of the interchain account of an owner on a connection
+func (k Keeper) GetConnectionPacketResults(ctx sdk.Context, owner, connectionID string) []types.PacketResult {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConnectionPacketResultsKey(owner, connectionID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	results := []types.PacketResult{}
+	for ; iterator.Valid(); iterator.Next() {
+		result := types.PacketResult{}
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// GetAllPacketResults returns the results of the packets of all interchain accounts
+func (k Keeper) GetAllPacketResults(ctx sdk.Context) []types.PacketResult {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PacketResultKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	results := []types.PacketResult{}
+	for ; iterator.Valid(); iterator.Next() {
+		result := types.PacketResult{}
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return results
+}
+
+// SetPruneQueueEntry queues a completed packet result to be pruned once the result retention of the params elapsed
+func (k Keeper) SetPruneQueueEntry(ctx sdk.Context, entry types.PruneQueueEntry) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.PacketResultKey(entry.Owner, entry.ConnectionId, entry.ChannelId, entry.Sequence)
+	store.Set(types.PruneQueueKey(entry.CompletionTime, key), []byte{})
+}
+
+// GetPruneQueue returns the completed packet results queued to be pruned, by completion time
+func (k Keeper) GetPruneQueue(ctx sdk.Context) []types.PruneQueueEntry {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PruneQueueKeyPrefix)
+	defer iterator.Close()
+
+	entries := []types.PruneQueueEntry{}
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, resultKey, err := types.SplitPruneQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		owner, connectionID, channelID, sequence := types.SplitPacketResultKey(resultKey)
+		entries = append(entries, types.PruneQueueEntry{
+			CompletionTime: completionTime,
+			Owner:          owner,
+			ConnectionId:   connectionID,
+			ChannelId:      channelID,
+			Sequence:       sequence,
+		})
+	}
+	return entries
+}
+
+// getPacketResult returns the result of a packet sent on a controller port, the packet may have been sent
+// on a previous channel of the interchain account
+func (k Keeper) getPacketResult(ctx sdk.Context, packet channeltypes.Packet) (types.PacketResult, bool) {
+	if !strings.HasPrefix(packet.SourcePort, icatypes.PortPrefix) {
+		return types.PacketResult{}, false
+	}
+
+	owner := strings.TrimPrefix(packet.SourcePort, icatypes.PortPrefix)
+	for _, account := range k.GetOwnerInterchainAccounts(ctx, owner) {
+		if result, found := k.GetPacketResult(ctx, owner, account.ConnectionId, packet.SourceChannel, packet.Sequence); found {
+			return result, true
+		}
+	}
+	return types.PacketResult{}, false
+}
+
+// completePacketResult records the outcome of a packet sent by the module, packets of untracked
+// interchain accounts are ignored
+func (k Keeper) completePacketResult(ctx sdk.Context, packet channeltypes.Packet, complete func(result *types.PacketResult)) {
+	result, found := k.getPacketResult(ctx, packet)
+	if !found || result.Status != types.PacketPending {
+		return
+	}
+
+	complete(&result)
+	result.CompletionTime = ctx.BlockTime()
+	k.SetPacketResult(ctx, result)
+}
+
+// OnPacketSuccess records the responses of the messages of a packet executed by the host chain
+func (k Keeper) OnPacketSuccess(ctx sdk.Context, packet channeltypes.Packet, responses []*sdk.MsgData) {
+	k.completePacketResult(ctx, packet, func(result *types.PacketResult) {
+		result.Status = types.PacketSuccess
+		result.Responses = responses
+	})
+}
+
+// OnPacketError records the error of a packet rejected by the host chain
+func (k Keeper) OnPacketError(ctx sdk.Context, packet channeltypes.Packet, err string) {
+	k.completePacketResult(ctx, packet, func(result *types.PacketResult) {
+		result.Status = types.PacketError
+		result.Error = err
+	})
+}
+
+// OnPacketTimeout records the timeout of a packet
+func (k Keeper) OnPacketTimeout(ctx sdk.Context, packet channeltypes.Packet) {
+	k.completePacketResult(ctx, packet, func(result *types.PacketResult) {
+		result.Status = types.PacketTimeout
+	})
+}
+
+// PruneExpiredPacketResults deletes the results completed before the result retention of the params, at most
+// MaxPrunedResultsPerBlock of them, the others are deleted at the end of the next blocks
+func (k Keeper) PruneExpiredPacketResults(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	expiry := ctx.BlockTime().Add(-k.GetParams(ctx).ResultRetention)
+	iterator := store.Iterator(types.PruneQueueKeyPrefix, sdk.PrefixEndBytes(types.PruneQueueTimeKey(expiry)))
+
+	queueKeys := [][]byte{}
+	for ; iterator.Valid() && len(queueKeys) < MaxPrunedResultsPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	timeKeyLen := len(types.PruneQueueTimeKey(expiry))
+	for _, queueKey := range queueKeys {
+		store.Delete(queueKey)
+		store.Delete(queueKey[timeKeyLen:])
+	}
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+
+	"github.com/furysport/fury-chain/x/intertx/keeper"
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+func (suite *KeeperTestSuite) TestPacketResults() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.setupAccount(path)
+
+	icaApp := suite.GetICAApp(suite.chainA)
+	hostApp := suite.GetICAApp(suite.chainB)
+	k := icaApp.InterTxKeeper
+	account, _ := k.GetInterchainAccount(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID)
+	icaAddr := sdk.MustAccAddressFromBech32(account.Address)
+	recipient := sdk.AccAddress("recipient___________")
+
+	hostApp.ICAHostKeeper.SetParams(suite.chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+	_, err := suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	// submitTx submits the messages, relays their packet and its acknowledgement, it returns the packet sequence
+	submitTx := func(msgs ...sdk.Msg) uint64 {
+		msg, err := types.NewMsgSubmitTx(msgs, path.EndpointA.ConnectionID, TestOwnerAddress)
+		suite.Require().NoError(err)
+		res, err := keeper.NewMsgServerImpl(k).SubmitTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+		suite.Require().NoError(err)
+		suite.chainA.App.Commit()
+		suite.chainA.NextBlock()
+
+		result, found := k.GetPacketResult(suite.chainA.GetContext(), TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, res.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(types.PacketPending, result.Status)
+		suite.Require().Equal(res.TimeoutTimestamp, result.TimeoutTimestamp)
+
+		suite.Require().NoError(path.EndpointB.UpdateClient())
+		data, err := icatypes.SerializeCosmosTx(icaApp.AppCodec(), msgs)
+		suite.Require().NoError(err)
+		packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+		packet := channeltypes.NewPacket(packetData.GetBytes(), res.Sequence, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), res.TimeoutTimestamp)
+		recvRes, err := path.EndpointB.RecvPacketWithResult(packet)
+		suite.Require().NoError(err)
+		ack, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(path.EndpointA.UpdateClient())
+		suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ack))
+		return res.Sequence
+	}
+
+	// the responses of the executed messages are recorded
+	successSeq := submitTx(
+		banktypes.NewMsgSend(icaAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		banktypes.NewMsgSend(icaAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+	)
+	ctx := suite.chainA.GetContext()
+	result, found := k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, successSeq)
+	suite.Require().True(found)
+	suite.Require().Equal(types.PacketSuccess, result.Status)
+	suite.Require().Len(result.Responses, 2)
+	suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), result.Responses[0].MsgType)
+	suite.Require().Empty(result.Error)
+
+	// as well as the error of a rejected packet
+	errorSeq := submitTx(banktypes.NewMsgSend(icaAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	ctx = suite.chainA.GetContext()
+	result, _ = k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, errorSeq)
+	suite.Require().Equal(types.PacketError, result.Status)
+	suite.Require().NotEmpty(result.Error)
+	suite.Require().Empty(result.Responses)
+
+	// and the timeout of a packet
+	timeoutSeq := errorSeq + 1
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     timeoutSeq,
+		Status:       types.PacketPending,
+	})
+	k.OnPacketTimeout(ctx, channeltypes.NewPacket(nil, timeoutSeq, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0))
+	result, _ = k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, timeoutSeq)
+	suite.Require().Equal(types.PacketTimeout, result.Status)
+	suite.Require().Equal(ctx.BlockTime(), result.CompletionTime)
+
+	// completed results are not overwritten
+	k.OnPacketError(ctx, channeltypes.NewPacket(nil, timeoutSeq, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0), "error")
+	result, _ = k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, timeoutSeq)
+	suite.Require().Equal(types.PacketTimeout, result.Status)
+
+	// the results are queried by owner and connection
+	res, err := k.PacketResults(sdk.WrapSDKContext(ctx), &types.QueryPacketResultsRequest{Owner: TestOwnerAddress, ConnectionId: path.EndpointA.ConnectionID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 3)
+	suite.Require().Equal(successSeq, res.Results[0].Sequence)
+	suite.Require().Equal(errorSeq, res.Results[1].Sequence)
+	suite.Require().Equal(timeoutSeq, res.Results[2].Sequence)
+
+	resultRes, err := k.PacketResult(sdk.WrapSDKContext(ctx), &types.QueryPacketResultRequest{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     errorSeq,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Results[1], resultRes.Result)
+
+	_, err = k.PacketResult(sdk.WrapSDKContext(ctx), &types.QueryPacketResultRequest{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     timeoutSeq + 1,
+	})
+	suite.Require().Error(err)
+
+	// the results are pruned once the result retention elapsed since their completion
+	retention := k.GetParams(ctx).ResultRetention
+	k.PruneExpiredPacketResults(ctx.WithBlockTime(res.Results[0].CompletionTime.Add(retention).Add(-time.Second)))
+	suite.Require().Len(k.GetConnectionPacketResults(ctx, TestOwnerAddress, path.EndpointA.ConnectionID), 3)
+
+	k.PruneExpiredPacketResults(ctx.WithBlockTime(res.Results[2].CompletionTime.Add(retention).Add(-time.Second)))
+	results := k.GetConnectionPacketResults(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().Len(results, 1)
+	suite.Require().Equal(timeoutSeq, results[0].Sequence)
+
+	k.PruneExpiredPacketResults(ctx.WithBlockTime(res.Results[2].CompletionTime.Add(retention)))
+	suite.Require().Empty(k.GetConnectionPacketResults(ctx, TestOwnerAddress, path.EndpointA.ConnectionID))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredPacketResultsLimit() {
+	k := suite.GetICAApp(suite.chainA).InterTxKeeper
+	ctx := suite.chainA.GetContext()
+
+	for sequence := uint64(1); sequence <= keeper.MaxPrunedResultsPerBlock+1; sequence++ {
+		k.SetPacketResult(ctx, types.PacketResult{
+			Owner:          TestOwnerAddress,
+			ConnectionId:   ibctesting.FirstConnectionID,
+			ChannelId:      ibctesting.FirstChannelID,
+			Sequence:       sequence,
+			Status:         types.PacketSuccess,
+			CompletionTime: ctx.BlockTime(),
+		})
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(k.GetParams(ctx).ResultRetention))
+	k.PruneExpiredPacketResults(ctx)
+	suite.Require().Len(k.GetConnectionPacketResults(ctx, TestOwnerAddress, ibctesting.FirstConnectionID), 1)
+	k.PruneExpiredPacketResults(ctx)
+	suite.Require().Empty(k.GetConnectionPacketResults(ctx, TestOwnerAddress, ibctesting.FirstConnectionID))
+}
+
+func (suite *KeeperTestSuite) TestPacketResultsAfterReopen() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+	suite.setupAccount(path)
+
+	k := suite.GetICAApp(suite.chainA).InterTxKeeper
+	ctx := suite.chainA.GetContext()
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     1,
+		Status:       types.PacketPending,
+	})
+	k.SetPacketResult(ctx, types.PacketResult{
+		Owner:        TestOwnerAddress,
+		ConnectionId: path.EndpointA.ConnectionID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     2,
+		Status:       types.PacketPending,
+	})
+
+	// the account moved to a re-opened channel
+	account, _ := k.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	account.ChannelId = "channel-1"
+	k.SetInterchainAccount(ctx, account)
+
+	// the packets still in flight on the previous channel are completed
+	k.OnPacketTimeout(ctx, channeltypes.NewPacket(nil, 1, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0))
+	result, _ := k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 1)
+	suite.Require().Equal(types.PacketTimeout, result.Status)
+
+	k.OnPacketSuccess(ctx, channeltypes.NewPacket(nil, 2, TestPortID, path.EndpointA.ChannelID, icatypes.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0), nil)
+	result, _ = k.GetPacketResult(ctx, TestOwnerAddress, path.EndpointA.ConnectionID, path.EndpointA.ChannelID, 2)
+	suite.Require().Equal(types.PacketSuccess, result.Status)
+
+	// without closing the account of the re-opened channel
+	k.OnAccountChannelClosed(ctx, TestPortID, path.EndpointA.ChannelID)
+	account, _ = k.GetInterchainAccount(ctx, TestOwnerAddress, path.EndpointA.ConnectionID)
+	suite.Require().Equal(types.AccountActive, account.Status)
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/furysport/fury-chain/x/intertx/types"
+)
+
+// GetParams returns the intertx params from the global param store,
+// params not set yet keep their default value
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	p := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &p)
+	return p
+}
+
+// SetParams sets the intertx params to the global param store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,604 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: intertx/account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountStatus defines the lifecycle status of an interchain account channel.
+type AccountStatus int32
+
+const (
+	// AccountPending is an account whose channel handshake is in progress.
+	AccountPending AccountStatus = 0
+	// AccountActive is an account whose channel is open.
+	AccountActive AccountStatus = 1
+	// AccountClosed is an account whose channel closed, e.g. on a packet timeout, and is being re-opened.
+	AccountClosed AccountStatus = 2
+)
+
+var AccountStatus_name = map[int32]string{
+	0: "AccountPending",
+	1: "AccountActive",
+	2: "AccountClosed",
+}
+
+var AccountStatus_value = map[string]int32{
+	"AccountPending": 0,
+	"AccountActive":  1,
+	"AccountClosed":  2,
+}
+
+func (x AccountStatus) String() string {
+	return proto.EnumName(AccountStatus_name, int32(x))
+}
+
+func (AccountStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_77fdd0fffd09e2f4, []int{0}
+}
+
+// InterchainAccount tracks the interchain account of an owner on a connection.
+type InterchainAccount struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId       string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel_id is the last channel opened for the account.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// address is the address of the account on the host chain, set once the channel is open.
+	Address string        `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Status  AccountStatus `protobuf:"varint,6,opt,name=status,proto3,enum=intertx.AccountStatus" json:"status,omitempty"`
+}
+
+func (m *InterchainAccount) Reset()         { *m = InterchainAccount{} }
+func (m *InterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccount) ProtoMessage()    {}
+func (*InterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77fdd0fffd09e2f4, []int{0}
+}
+func (m *InterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccount.Merge(m, src)
+}
+func (m *InterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccount proto.InternalMessageInfo
+
+func (m *InterchainAccount) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *InterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InterchainAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *InterchainAccount) GetStatus() AccountStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AccountPending
+}
+
+func init() {
+	proto.RegisterEnum("intertx.AccountStatus", AccountStatus_name, AccountStatus_value)
+	proto.RegisterType((*InterchainAccount)(nil), "intertx.InterchainAccount")
+}
+
+func init() { proto.RegisterFile("intertx/account.proto", fileDescriptor_77fdd0fffd09e2f4) }
+
+var fileDescriptor_77fdd0fffd09e2f4 = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x6e, 0xda, 0x40,
+	0x1c, 0xc6, 0x7d, 0x14, 0x8c, 0x38, 0x15, 0x04, 0x27, 0xa8, 0x2c, 0x0f, 0xc6, 0xb2, 0xaa, 0x0a,
+	0xb5, 0xaa, 0x2d, 0xb5, 0x9d, 0x2a, 0x75, 0x80, 0x4e, 0xde, 0x22, 0x67, 0xcb, 0x12, 0x99, 0xbb,
+	0x8b, 0xb1, 0x04, 0x77, 0xc8, 0x3e, 0x27, 0xf0, 0x06, 0x09, 0x53, 0x96, 0x8c, 0x4c, 0x79, 0x99,
+	0x8c, 0x8c, 0x99, 0x50, 0x04, 0x6f, 0xc0, 0x13, 0x44, 0x3e, 0x1f, 0x24, 0xce, 0xf6, 0xff, 0xbe,
+	0xdf, 0xf7, 0xc9, 0xf6, 0xff, 0x6f, 0xd8, 0x8b, 0x99, 0xa0, 0x89, 0x58, 0x78, 0x21, 0xc6, 0x3c,
+	0x63, 0xc2, 0x9d, 0x27, 0x5c, 0x70, 0x54, 0x57, 0xb6, 0xd9, 0x8d, 0x78, 0xc4, 0xa5, 0xe7, 0xe5,
+	0x53, 0x81, 0x9d, 0x87, 0x0a, 0xec, 0xf8, 0x79, 0x02, 0x4f, 0xc2, 0x98, 0x0d, 0x8b, 0x2a, 0xea,
+	0xc2, 0x1a, 0xbf, 0x61, 0x34, 0x31, 0x80, 0x0d, 0x06, 0x8d, 0xa0, 0x10, 0xe8, 0x1f, 0x6c, 0x62,
+	0xce, 0x18, 0xc5, 0x22, 0xe6, 0xec, 0x32, 0x26, 0x46, 0x25, 0xa7, 0x23, 0xe3, 0xb0, 0xed, 0x77,
+	0x97, 0xe1, 0x6c, 0xfa, 0xd7, 0x29, 0x61, 0x27, 0xf8, 0xfc, 0xa6, 0x7d, 0x82, 0x7e, 0xc0, 0xfa,
+	0x9c, 0x27, 0x22, 0x2f, 0x7e, 0x92, 0x45, 0x74, 0xd8, 0xf6, 0x5b, 0x45, 0x51, 0x01, 0x27, 0xd0,
+	0xf3, 0xc9, 0x27, 0xe8, 0x0f, 0x84, 0x78, 0x12, 0x32, 0x46, 0xa7, 0x79, 0xbe, 0x2a, 0xf3, 0xbd,
+	0xc3, 0xb6, 0xdf, 0x51, 0x0f, 0x3a, 0x31, 0x27, 0x68, 0x28, 0xe1, 0x13, 0x64, 0xc0, 0x7a, 0x48,
+	0x48, 0x42, 0xd3, 0xd4, 0xa8, 0xc9, 0x37, 0x3f, 0x4a, 0xe4, 0x42, 0x3d, 0x15, 0xa1, 0xc8, 0x52,
+	0x43, 0xb7, 0xc1, 0xa0, 0xf5, 0xeb, 0x8b, 0xab, 0xf6, 0xe2, 0xaa, 0x6f, 0x3e, 0x97, 0x34, 0x50,
+	0xa9, 0xef, 0x77, 0x00, 0x36, 0x4b, 0x04, 0x7d, 0x83, 0x2d, 0x65, 0x9c, 0x51, 0x46, 0x62, 0x16,
+	0xb5, 0x35, 0x13, 0xad, 0xd6, 0xf6, 0x07, 0x17, 0x7d, 0x3d, 0x15, 0x87, 0x58, 0xc4, 0xd7, 0xb4,
+	0x0d, 0xcc, 0xce, 0x6a, 0x6d, 0x97, 0xcd, 0x77, 0xa9, 0xff, 0x53, 0x9e, 0x52, 0xd2, 0xae, 0x94,
+	0x52, 0x85, 0x69, 0x56, 0x6f, 0x1f, 0x2d, 0x6d, 0xe4, 0x3f, 0xed, 0x2c, 0xb0, 0xd9, 0x59, 0xe0,
+	0x65, 0x67, 0x81, 0xfb, 0xbd, 0xa5, 0x6d, 0xf6, 0x96, 0xf6, 0xbc, 0xb7, 0xb4, 0x0b, 0x2f, 0x8a,
+	0xc5, 0x24, 0x1b, 0xbb, 0x98, 0xcf, 0xbc, 0xab, 0x2c, 0x59, 0xa6, 0xf9, 0xf6, 0xe4, 0xf4, 0x53,
+	0xde, 0xd3, 0x5b, 0x78, 0xc7, 0xbf, 0x42, 0x2c, 0xe7, 0x34, 0x1d, 0xeb, 0xf2, 0xea, 0xbf, 0x5f,
+	0x07, 0x00, 0x4d, 0x9c, 0x1f, 0x0b, 0x2d, 0x02, 0x00, 0x00,
+}
+
+func (m *InterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAccount(uint64(m.Status))
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AccountStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccount = fmt.Errorf("proto: unexpected end of group")
+)
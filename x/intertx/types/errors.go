@@ -7,4 +7,5 @@ import (
 var (
 	ErrIBCAccountAlreadyExist = sdkerrors.Register(ModuleName, 2, "interchain account already registered")
 	ErrIBCAccountNotExist     = sdkerrors.Register(ModuleName, 3, "interchain account not exist")
+	ErrInvalidTimeout         = sdkerrors.Register(ModuleName, 4, "invalid timeout")
 )
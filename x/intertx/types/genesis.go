@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// DefaultGenesis returns the default intertx genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	accounts := map[string]InterchainAccount{}
+	for _, account := range gs.Accounts {
+		if account.Owner == "" || account.ConnectionId == "" {
+			return fmt.Errorf("interchain account without owner or connection")
+		}
+		if account.PortId != icatypes.PortPrefix+account.Owner {
+			return fmt.Errorf("invalid port of interchain account of %s on %s: %s", account.Owner, account.ConnectionId, account.PortId)
+		}
+		key := string(AccountKey(account.Owner, account.ConnectionId))
+		if _, found := accounts[key]; found {
+			return fmt.Errorf("duplicate interchain account of %s on %s", account.Owner, account.ConnectionId)
+		}
+		accounts[key] = account
+	}
+
+	for _, entry := range gs.ReopenQueue {
+		account, found := accounts[string(AccountKey(entry.Owner, entry.ConnectionId))]
+		if !found || account.Status != AccountClosed {
+			return fmt.Errorf("re-open queue entry of %s on %s without closed interchain account", entry.Owner, entry.ConnectionId)
+		}
+	}
+
+	completed := map[string]PacketResult{}
+	results := map[string]bool{}
+	for _, result := range gs.PacketResults {
+		if _, found := accounts[string(AccountKey(result.Owner, result.ConnectionId))]; !found {
+			return fmt.Errorf("packet result %d of %s on %s without interchain account", result.Sequence, result.Owner, result.ConnectionId)
+		}
+		key := string(PacketResultKey(result.Owner, result.ConnectionId, result.ChannelId, result.Sequence))
+		if results[key] {
+			return fmt.Errorf("duplicate packet result %d of %s on %s", result.Sequence, result.ChannelId, result.ConnectionId)
+		}
+		results[key] = true
+		if result.Status != PacketPending {
+			completed[key] = result
+		}
+	}
+
+	// completed packet results are queued to be pruned at their completion time
+	queued := map[string]bool{}
+	for _, entry := range gs.PruneQueue {
+		key := string(PacketResultKey(entry.Owner, entry.ConnectionId, entry.ChannelId, entry.Sequence))
+		result, found := completed[key]
+		if !found || !result.CompletionTime.Equal(entry.CompletionTime) || queued[key] {
+			return fmt.Errorf("prune queue entry of packet result %d of %s on %s without completed packet result", entry.Sequence, entry.ChannelId, entry.ConnectionId)
+		}
+		queued[key] = true
+	}
+	if len(queued) != len(completed) {
+		return fmt.Errorf("completed packet results missing from the prune queue")
+	}
+	return nil
+}
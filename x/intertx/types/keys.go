@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName = "intertx"
 
@@ -9,3 +16,77 @@ const (
 
 	QuerierRoute = ModuleName
 )
+
+var (
+	// AccountKeyPrefix stores the interchain accounts by owner and connection
+	AccountKeyPrefix = []byte{0x01}
+	// ReopenQueueKeyPrefix stores the closed interchain accounts to re-open at the end of the block
+	ReopenQueueKeyPrefix = []byte{0x02}
+	// PacketResultKeyPrefix stores the packet results by owner, connection, channel and sequence
+	PacketResultKeyPrefix = []byte{0x03}
+	// PruneQueueKeyPrefix stores the keys of the completed packet results by completion time
+	PruneQueueKeyPrefix = []byte{0x04}
+)
+
+// OwnerAccountsKey returns the prefix of the interchain accounts of an owner
+func OwnerAccountsKey(owner string) []byte {
+	return append(AccountKeyPrefix, address.MustLengthPrefix([]byte(owner))...)
+}
+
+// AccountKey returns the key of the interchain account of an owner on a connection
+func AccountKey(owner, connectionID string) []byte {
+	return append(OwnerAccountsKey(owner), []byte(connectionID)...)
+}
+
+// ReopenQueueKey returns the key of a closed interchain account in the re-open queue
+func ReopenQueueKey(owner, connectionID string) []byte {
+	return append(append(ReopenQueueKeyPrefix, address.MustLengthPrefix([]byte(owner))...), []byte(connectionID)...)
+}
+
+// SplitReopenQueueKey returns the owner and connection of a re-open queue key
+func SplitReopenQueueKey(key []byte) (owner, connectionID string) {
+	ownerLen := int(key[len(ReopenQueueKeyPrefix)])
+	ownerStart := len(ReopenQueueKeyPrefix) + 1
+	return string(key[ownerStart : ownerStart+ownerLen]), string(key[ownerStart+ownerLen:])
+}
+
+// ConnectionPacketResultsKey returns the prefix of the packet results of an owner on a connection
+func ConnectionPacketResultsKey(owner, connectionID string) []byte {
+	key := append(PacketResultKeyPrefix, address.MustLengthPrefix([]byte(owner))...)
+	return append(key, address.MustLengthPrefix([]byte(connectionID))...)
+}
+
+// PacketResultKey returns the key of the result of a packet sent on a channel of the interchain account of an owner
+func PacketResultKey(owner, connectionID, channelID string, sequence uint64) []byte {
+	key := append(ConnectionPacketResultsKey(owner, connectionID), address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// PruneQueueTimeKey returns the prefix of the packet results completed at a time in the prune queue
+func PruneQueueTimeKey(completionTime time.Time) []byte {
+	return append(PruneQueueKeyPrefix, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// PruneQueueKey returns the key of a completed packet result in the prune queue
+func PruneQueueKey(completionTime time.Time, resultKey []byte) []byte {
+	return append(PruneQueueTimeKey(completionTime), resultKey...)
+}
+
+// SplitPacketResultKey returns the owner, connection, channel and sequence of a packet result key
+func SplitPacketResultKey(key []byte) (owner, connectionID, channelID string, sequence uint64) {
+	key = key[len(PacketResultKeyPrefix):]
+	parts := make([]string, 3)
+	for i := range parts {
+		partLen := int(key[0])
+		parts[i] = string(key[1 : 1+partLen])
+		key = key[1+partLen:]
+	}
+	return parts[0], parts[1], parts[2], sdk.BigEndianToUint64(key)
+}
+
+// SplitPruneQueueKey returns the completion time and the packet result key of a prune queue key
+func SplitPruneQueueKey(key []byte) (completionTime time.Time, resultKey []byte, err error) {
+	timeKeyLen := len(PruneQueueTimeKey(time.Time{}))
+	completionTime, err = sdk.ParseTimeBytes(key[len(PruneQueueKeyPrefix):timeKeyLen])
+	return completionTime, key[timeKeyLen:], err
+}
@@ -17,6 +17,9 @@ var (
 	_ codectypes.UnpackInterfacesMessage = MsgSubmitTx{}
 )
 
+// MaxMemoLength is the max length of the memo of an interchain account packet
+const MaxMemoLength = 256
+
 // NewMsgRegisterAccount creates a new MsgRegisterAccount instance
 func NewMsgRegisterAccount(owner, connectionID string) *MsgRegisterAccount {
 	return &MsgRegisterAccount{
@@ -49,16 +52,20 @@ func (msg MsgRegisterAccount) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgSubmitTx creates and returns a new MsgSubmitTx instance
-func NewMsgSubmitTx(sdkMsg sdk.Msg, connectionID, owner string) (*MsgSubmitTx, error) {
-	any, err := PackTxMsgAny(sdkMsg)
-	if err != nil {
-		return nil, err
+func NewMsgSubmitTx(sdkMsgs []sdk.Msg, connectionID, owner string) (*MsgSubmitTx, error) {
+	anys := make([]*codectypes.Any, len(sdkMsgs))
+	for i, sdkMsg := range sdkMsgs {
+		any, err := PackTxMsgAny(sdkMsg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
 	}
 
 	return &MsgSubmitTx{
 		ConnectionId: connectionID,
 		Owner:        owner,
-		Msg:          any,
+		Msgs:         anys,
 	}, nil
 }
 
@@ -79,21 +86,28 @@ func PackTxMsgAny(sdkMsg sdk.Msg) (*codectypes.Any, error) {
 
 // UnpackInterfaces implements codectypes.UnpackInterfacesMessage
 func (msg MsgSubmitTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	var (
-		sdkMsg sdk.Msg
-	)
+	for _, any := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		if err := unpacker.UnpackAny(any, &sdkMsg); err != nil {
+			return err
+		}
+	}
 
-	return unpacker.UnpackAny(msg.Msg, &sdkMsg)
+	return nil
 }
 
-// GetTxMsg fetches the cached any message
-func (msg *MsgSubmitTx) GetTxMsg() sdk.Msg {
-	sdkMsg, ok := msg.Msg.GetCachedValue().(sdk.Msg)
-	if !ok {
-		return nil
+// GetTxMsgs fetches the cached any messages, it returns an error if a message was not unpacked
+func (msg *MsgSubmitTx) GetTxMsgs() ([]sdk.Msg, error) {
+	sdkMsgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		sdkMsg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not an sdk.Msg: %s", i, any.GetTypeUrl())
+		}
+		sdkMsgs[i] = sdkMsg
 	}
 
-	return sdkMsg
+	return sdkMsgs, nil
 }
 
 // GetSigners implements sdk.Msg
@@ -113,5 +127,27 @@ func (msg MsgSubmitTx) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address")
 	}
 
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no messages to submit")
+	}
+	for i, any := range msg.Msgs {
+		if any == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d is empty", i)
+		}
+	}
+
+	if len(msg.Memo) > MaxMemoLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "memo length %d exceeds %d", len(msg.Memo), MaxMemoLength)
+	}
+
+	if msg.Timeout != nil {
+		if *msg.Timeout <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidTimeout, "timeout must be positive: %s", *msg.Timeout)
+		}
+		if *msg.Timeout > TimeoutLimit {
+			return sdkerrors.Wrapf(ErrInvalidTimeout, "timeout %s exceeds the limit %s", *msg.Timeout, TimeoutLimit)
+		}
+	}
+
 	return nil
 }
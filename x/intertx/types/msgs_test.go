@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitTxValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	duration := func(d time.Duration) *time.Duration { return &d }
+
+	testCases := []struct {
+		name      string
+		owner     string
+		timeout   *time.Duration
+		expectErr error
+	}{
+		{"default timeout", owner, nil, nil},
+		{"relative timeout", owner, duration(time.Hour), nil},
+		{"timeout limit", owner, duration(TimeoutLimit), nil},
+		{"invalid owner", "owner", nil, sdkerrors.ErrInvalidAddress},
+		{"zero timeout", owner, duration(0), ErrInvalidTimeout},
+		{"negative timeout", owner, duration(-time.Minute), ErrInvalidTimeout},
+		{"timeout above the limit", owner, duration(TimeoutLimit + time.Second), ErrInvalidTimeout},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgSubmitTx([]sdk.Msg{&banktypes.MsgSend{}}, "connection-0", tc.owner)
+			require.NoError(t, err)
+			msg.Timeout = tc.timeout
+
+			err = msg.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, DefaultGenesis().Validate())
+	require.Error(t, NewParams(0, time.Hour, time.Hour).Validate())
+	require.Error(t, NewParams(time.Hour, TimeoutLimit+time.Second, time.Hour).Validate())
+	require.Error(t, NewParams(2*time.Hour, time.Hour, time.Hour).Validate())
+	require.Error(t, NewParams(time.Hour, time.Hour, 0).Validate())
+}
+
+func TestMsgSubmitTxValidateBasicMsgs(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+
+	msg, err := NewMsgSubmitTx([]sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}}, "connection-0", owner)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	txMsgs, err := msg.GetTxMsgs()
+	require.NoError(t, err)
+	require.Len(t, txMsgs, 2)
+
+	msg.Memo = strings.Repeat("m", MaxMemoLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrMemoTooLarge)
+
+	msg, err = NewMsgSubmitTx(nil, "connection-0", owner)
+	require.NoError(t, err)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,795 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: intertx/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PacketStatus defines the status of the packet of a submitted transaction.
+type PacketStatus int32
+
+const (
+	// PacketPending is a packet not acknowledged nor timed out yet.
+	PacketPending PacketStatus = 0
+	// PacketSuccess is a packet whose transaction the host chain executed.
+	PacketSuccess PacketStatus = 1
+	// PacketError is a packet whose transaction the host chain rejected.
+	PacketError PacketStatus = 2
+	// PacketTimeout is a packet that timed out before the host chain received it.
+	PacketTimeout PacketStatus = 3
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PacketPending",
+	1: "PacketSuccess",
+	2: "PacketError",
+	3: "PacketTimeout",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PacketPending": 0,
+	"PacketSuccess": 1,
+	"PacketError":   2,
+	"PacketTimeout": 3,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5e1f2cf7547cac8c, []int{0}
+}
+
+// PacketResult tracks the result of the packet of a transaction submitted to an interchain account.
+type PacketResult struct {
+	Owner        string       `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string       `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	ChannelId    string       `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Sequence     uint64       `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status       PacketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=intertx.PacketStatus" json:"status,omitempty"`
+	// responses are the responses of the messages executed by the host chain.
+	Responses []*types.MsgData `protobuf:"bytes,6,rep,name=responses,proto3" json:"responses,omitempty"`
+	// error is the error the host chain rejected the transaction with.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// timeout_timestamp is the timeout of the packet in unix nanoseconds.
+	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// submit_height is the height the transaction was submitted at.
+	SubmitHeight int64 `protobuf:"varint,9,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty" yaml:"submit_height"`
+	// completion_time is the block time the packet was acknowledged or timed out at.
+	CompletionTime time.Time `protobuf:"bytes,10,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *PacketResult) Reset()         { *m = PacketResult{} }
+func (m *PacketResult) String() string { return proto.CompactTextString(m) }
+func (*PacketResult) ProtoMessage()    {}
+func (*PacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e1f2cf7547cac8c, []int{0}
+}
+func (m *PacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketResult.Merge(m, src)
+}
+func (m *PacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketResult proto.InternalMessageInfo
+
+func (m *PacketResult) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PacketResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PacketResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PacketResult) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PacketPending
+}
+
+func (m *PacketResult) GetResponses() []*types.MsgData {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *PacketResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PacketResult) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *PacketResult) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *PacketResult) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("intertx.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*PacketResult)(nil), "intertx.PacketResult")
+}
+
+func init() { proto.RegisterFile("intertx/packet.proto", fileDescriptor_5e1f2cf7547cac8c) }
+
+var fileDescriptor_5e1f2cf7547cac8c = []byte{
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xbd, 0x6e, 0xdb, 0x3e,
+	0x14, 0xc5, 0xc5, 0xd8, 0xf9, 0x30, 0xe3, 0x24, 0xb6, 0xe0, 0xfc, 0x21, 0x08, 0x7f, 0x48, 0xaa,
+	0xda, 0x41, 0x28, 0x10, 0x09, 0x71, 0x3b, 0x15, 0x28, 0x0a, 0x18, 0x2d, 0x50, 0x0f, 0x05, 0x02,
+	0x25, 0x53, 0x17, 0x43, 0xa2, 0x19, 0x59, 0xa8, 0x45, 0xaa, 0x22, 0xd5, 0xc6, 0x6f, 0x50, 0x64,
+	0xca, 0x0b, 0x64, 0xea, 0xd2, 0xa9, 0xcf, 0x91, 0x31, 0x63, 0x27, 0xb7, 0xb0, 0xdf, 0xc0, 0x4f,
+	0x50, 0x90, 0x94, 0xbf, 0xd0, 0x8d, 0xe7, 0xdc, 0x73, 0x2f, 0xc5, 0x1f, 0x29, 0xd8, 0x49, 0x09,
+	0xc7, 0x05, 0xbf, 0x09, 0xf2, 0x08, 0x7d, 0xc2, 0xdc, 0xcf, 0x0b, 0xca, 0xa9, 0xbe, 0x5f, 0xb9,
+	0x66, 0x27, 0xa1, 0x09, 0x95, 0x5e, 0x20, 0x56, 0xaa, 0x6c, 0xda, 0x09, 0xa5, 0xc9, 0x18, 0x07,
+	0x52, 0xc5, 0xe5, 0x75, 0xc0, 0xd3, 0x0c, 0x33, 0x1e, 0x65, 0x79, 0x15, 0x78, 0x8a, 0x28, 0xcb,
+	0x28, 0x0b, 0xe2, 0x88, 0xe1, 0x20, 0x8a, 0x51, 0x1a, 0x7c, 0x39, 0x8f, 0x31, 0x8f, 0xce, 0xa5,
+	0x50, 0x21, 0xf7, 0x67, 0x1d, 0x36, 0x2f, 0xe4, 0xae, 0x21, 0x66, 0xe5, 0x98, 0xeb, 0x1d, 0xb8,
+	0x4b, 0xbf, 0x12, 0x5c, 0x18, 0xc0, 0x01, 0x5e, 0x23, 0x54, 0x42, 0x7f, 0x0d, 0x8f, 0x10, 0x25,
+	0x04, 0x23, 0x9e, 0x52, 0x32, 0x48, 0x87, 0xc6, 0x8e, 0xa8, 0xf6, 0x8c, 0xc5, 0xd4, 0xee, 0x4c,
+	0xa2, 0x6c, 0xfc, 0xca, 0xdd, 0x2a, 0xbb, 0x61, 0x73, 0xad, 0xfb, 0x43, 0xfd, 0x25, 0x84, 0x68,
+	0x14, 0x11, 0x82, 0xc7, 0xa2, 0xb7, 0x26, 0x7b, 0x4f, 0x17, 0x53, 0xbb, 0x5d, 0xf5, 0xae, 0x6a,
+	0x6e, 0xd8, 0xa8, 0x44, 0x7f, 0xa8, 0x9b, 0xf0, 0x80, 0xe1, 0xcf, 0x25, 0x26, 0x08, 0x1b, 0x75,
+	0x07, 0x78, 0xf5, 0x70, 0xa5, 0xf5, 0x33, 0xb8, 0xc7, 0x78, 0xc4, 0x4b, 0x66, 0xec, 0x3a, 0xc0,
+	0x3b, 0xee, 0x9e, 0xfa, 0x15, 0x2d, 0x5f, 0x9d, 0xe6, 0x52, 0x16, 0xc3, 0x2a, 0xa4, 0xbf, 0x81,
+	0x8d, 0x02, 0xb3, 0x9c, 0x12, 0x86, 0x99, 0xb1, 0xe7, 0xd4, 0xbc, 0xc3, 0xee, 0x13, 0x5f, 0xf1,
+	0xf1, 0x05, 0x1f, 0x5f, 0x22, 0xa9, 0xf8, 0xf8, 0x1f, 0x58, 0xf2, 0x36, 0xe2, 0x51, 0xb8, 0xee,
+	0x11, 0x58, 0x70, 0x51, 0xd0, 0xc2, 0xd8, 0x57, 0x58, 0xa4, 0xd0, 0xfb, 0xb0, 0x2d, 0xa8, 0xd3,
+	0x92, 0x0f, 0x56, 0xf4, 0x8d, 0x03, 0xf1, 0xa9, 0xbd, 0xff, 0x17, 0x53, 0xdb, 0x50, 0xc7, 0xfb,
+	0x27, 0xe2, 0x86, 0xad, 0xca, 0xbb, 0x5a, 0x5a, 0x82, 0x30, 0x2b, 0xe3, 0x2c, 0xe5, 0x83, 0x11,
+	0x4e, 0x93, 0x11, 0x37, 0x1a, 0x0e, 0xf0, 0x6a, 0x9b, 0x84, 0xb7, 0xca, 0x6e, 0xd8, 0x54, 0xfa,
+	0xbd, 0x94, 0x7a, 0x02, 0x4f, 0x10, 0xcd, 0xf2, 0x31, 0x96, 0x37, 0x20, 0xa6, 0x1b, 0xd0, 0x01,
+	0xde, 0x61, 0xd7, 0xf4, 0xd5, 0x3b, 0xf1, 0x97, 0xef, 0xc4, 0x5f, 0xed, 0xd9, 0x73, 0x1f, 0xa6,
+	0xb6, 0xb6, 0x98, 0xda, 0xff, 0x2d, 0xaf, 0x70, 0x6b, 0x80, 0x7b, 0xf7, 0xdb, 0x06, 0xe1, 0xf1,
+	0xda, 0x15, 0x8d, 0xcf, 0x7f, 0x00, 0xd8, 0xdc, 0x44, 0xac, 0x3f, 0x83, 0x47, 0x4a, 0x5f, 0x60,
+	0x32, 0x4c, 0x49, 0xd2, 0xd2, 0xcc, 0xf6, 0xed, 0xbd, 0xb3, 0x6d, 0xae, 0x53, 0x97, 0x25, 0x42,
+	0x98, 0xb1, 0x16, 0xd8, 0x4c, 0x55, 0xa6, 0xee, 0xc0, 0x43, 0x65, 0xbc, 0x13, 0x78, 0x5b, 0x3b,
+	0xe6, 0xc9, 0xed, 0xbd, 0xb3, 0x69, 0xad, 0xe7, 0x5c, 0x29, 0x80, 0xad, 0xda, 0xe6, 0x9c, 0xca,
+	0x34, 0xeb, 0xdf, 0xbe, 0x5b, 0x5a, 0xaf, 0xff, 0x30, 0xb3, 0xc0, 0xe3, 0xcc, 0x02, 0x7f, 0x66,
+	0x16, 0xb8, 0x9b, 0x5b, 0xda, 0xe3, 0xdc, 0xd2, 0x7e, 0xcd, 0x2d, 0xed, 0x63, 0x90, 0xa4, 0x7c,
+	0x54, 0xc6, 0x3e, 0xa2, 0x59, 0x70, 0x5d, 0x16, 0x13, 0x96, 0xd3, 0x82, 0xcb, 0xd5, 0x19, 0x1a,
+	0x45, 0x29, 0x09, 0x6e, 0x82, 0xe5, 0x2f, 0xc9, 0x27, 0x39, 0x66, 0xf1, 0x9e, 0xa4, 0xf7, 0xe2,
+	0xef, 0x00, 0xda, 0x63, 0x1e, 0x2d, 0xaa, 0x03, 0x00, 0x00,
+}
+
+func (m *PacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPacket(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.SubmitHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPacket(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovPacket(uint64(m.TimeoutTimestamp))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovPacket(uint64(m.SubmitHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovPacket(uint64(l))
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PacketResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &types.MsgData{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
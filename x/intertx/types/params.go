@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// TimeoutLimit is the largest relative timeout accepted by the module, whatever its params
+const TimeoutLimit = 30 * 24 * time.Hour
+
+// parameter keys
+var (
+	KeyDefaultTimeout  = []byte("DefaultTimeout")
+	KeyMaxTimeout      = []byte("MaxTimeout")
+	KeyResultRetention = []byte("ResultRetention")
+)
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDefaultTimeout, &p.DefaultTimeout, validateTimeout),
+		paramtypes.NewParamSetPair(KeyMaxTimeout, &p.MaxTimeout, validateTimeout),
+		paramtypes.NewParamSetPair(KeyResultRetention, &p.ResultRetention, validateResultRetention),
+	}
+}
+
+// NewParams constructs a new Params instance
+func NewParams(defaultTimeout, maxTimeout, resultRetention time.Duration) Params {
+	return Params{
+		DefaultTimeout:  defaultTimeout,
+		MaxTimeout:      maxTimeout,
+		ResultRetention: resultRetention,
+	}
+}
+
+// ParamKeyTable returns the TypeTable for the module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams return the default params
+func DefaultParams() Params {
+	return NewParams(10*time.Minute, 24*time.Hour, 7*24*time.Hour)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateTimeout(p.DefaultTimeout); err != nil {
+		return err
+	}
+	if err := validateTimeout(p.MaxTimeout); err != nil {
+		return err
+	}
+	if err := validateResultRetention(p.ResultRetention); err != nil {
+		return err
+	}
+	if p.DefaultTimeout > p.MaxTimeout {
+		return fmt.Errorf("default timeout %s exceeds the max timeout %s", p.DefaultTimeout, p.MaxTimeout)
+	}
+
+	return nil
+}
+
+func validateTimeout(i interface{}) error {
+	timeout, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("timeout must be positive: %s", timeout)
+	}
+	if timeout > TimeoutLimit {
+		return fmt.Errorf("timeout %s exceeds the limit %s", timeout, TimeoutLimit)
+	}
+
+	return nil
+}
+
+func validateResultRetention(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if retention <= 0 {
+		return fmt.Errorf("result retention must be positive: %s", retention)
+	}
+
+	return nil
+}
@@ -10,18 +10,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -107,9 +111,14 @@ var xxx_messageInfo_MsgRegisterAccountResponse proto.InternalMessageInfo
 
 // MsgSubmitTx defines the payload for Msg/SubmitTx
 type MsgSubmitTx struct {
-	Owner        string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	ConnectionId string     `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	Msg          *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// msgs are executed atomically by the interchain account on the host chain
+	Msgs []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// timeout is the timeout of the packet relative to the block time, the default timeout of the params when empty
+	Timeout *time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout,omitempty"`
+	// memo is the memo of the interchain account packet
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *MsgSubmitTx) Reset()         { *m = MsgSubmitTx{} }
@@ -147,6 +156,10 @@ var xxx_messageInfo_MsgSubmitTx proto.InternalMessageInfo
 
 // MsgSubmitTxResponse defines the response for Msg/SubmitTx
 type MsgSubmitTxResponse struct {
+	// sequence is the sequence of the packet sent on the interchain account channel
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// timeout_timestamp is the timeout of the packet in unix nanoseconds
+	TimeoutTimestamp uint64 `protobuf:"varint,2,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 }
 
 func (m *MsgSubmitTxResponse) Reset()         { *m = MsgSubmitTxResponse{} }
@@ -182,6 +195,20 @@ func (m *MsgSubmitTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitTxResponse proto.InternalMessageInfo
 
+func (m *MsgSubmitTxResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MsgSubmitTxResponse) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgRegisterAccount)(nil), "intertx.MsgRegisterAccount")
 	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "intertx.MsgRegisterAccountResponse")
@@ -192,30 +219,36 @@ func init() {
 func init() { proto.RegisterFile("intertx/tx.proto", fileDescriptor_cf629f09c7954014) }
 
 var fileDescriptor_cf629f09c7954014 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc8, 0xcc, 0x2b, 0x49,
-	0x2d, 0x2a, 0xa9, 0xd0, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x87, 0x8a,
-	0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf4, 0x41, 0x2c, 0x88, 0xb4, 0x94, 0x64, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x91,
-	0x52, 0x2a, 0xe6, 0x12, 0xf2, 0x2d, 0x4e, 0x0f, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x49, 0x2d, 0x72,
-	0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x11, 0x12, 0xe1, 0x62, 0xcd, 0x2f, 0xcf, 0x4b, 0x2d, 0x92,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x6c, 0xb9, 0x78, 0x93, 0xf3, 0xf3, 0xf2,
-	0x52, 0x93, 0x4b, 0x32, 0xf3, 0xf3, 0xe2, 0x33, 0x53, 0x24, 0x98, 0x40, 0xb2, 0x4e, 0x12, 0x9f,
-	0xee, 0xc9, 0x8b, 0x54, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x48, 0x2b, 0x05, 0xf1, 0x20, 0xf8,
-	0x9e, 0x29, 0x56, 0x1c, 0x1d, 0x0b, 0xe4, 0x19, 0x5e, 0x2c, 0x90, 0x67, 0x50, 0x92, 0xe1, 0x92,
-	0xc2, 0xb4, 0x34, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0x69, 0x0a, 0x23, 0x17, 0xb7,
-	0x6f, 0x71, 0x7a, 0x70, 0x69, 0x52, 0x6e, 0x66, 0x49, 0x48, 0x05, 0x4d, 0x1c, 0x23, 0xa4, 0xc6,
-	0xc5, 0x9c, 0x5b, 0x9c, 0x2e, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0x09, 0x20,
-	0x3d, 0x58, 0x00, 0xe9, 0x39, 0xe6, 0x55, 0x06, 0x81, 0x14, 0x20, 0x39, 0x5a, 0x94, 0x4b, 0x18,
-	0xc9, 0x55, 0x30, 0xd7, 0x1a, 0xcd, 0x62, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x0a, 0xe6, 0xe2,
-	0x47, 0x0f, 0x45, 0x69, 0x3d, 0x68, 0xb4, 0xe8, 0x61, 0xfa, 0x56, 0x4a, 0x19, 0x8f, 0x24, 0xcc,
-	0x70, 0x21, 0x3b, 0x2e, 0x0e, 0x44, 0x30, 0x20, 0x6b, 0x80, 0x89, 0x4a, 0xc9, 0x60, 0x13, 0x85,
-	0xe9, 0x77, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62,
-	0x7d, 0xb0, 0x41, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xba, 0x89, 0x10, 0x77, 0x14, 0xeb, 0x57, 0x40,
-	0x44, 0x75, 0x41, 0x89, 0xad, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x44, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x0c, 0x24, 0x8c, 0xe5, 0x84, 0x02, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x11, 0x97, 0x86, 0x0b, 0x88, 0x72, 0x64, 0x70, 0x4d, 0x64, 0x47, 0x66, 0xc9, 0x82,
+	0x2d, 0x85, 0x89, 0x4a, 0x20, 0x35, 0x62, 0xc9, 0x90, 0xe5, 0xda, 0x89, 0xa5, 0x72, 0x9c, 0xeb,
+	0xf5, 0xa4, 0xde, 0x9d, 0xf1, 0xdd, 0x89, 0x58, 0xe2, 0x07, 0x30, 0x32, 0x22, 0xa6, 0xfe, 0x1c,
+	0xc6, 0x8e, 0x4c, 0x05, 0x25, 0x42, 0x62, 0xee, 0x2f, 0x40, 0x39, 0xdb, 0x69, 0x68, 0x10, 0x5b,
+	0x27, 0x7f, 0xdf, 0x7b, 0xdf, 0x67, 0xdf, 0x7b, 0xcf, 0x07, 0xf7, 0x98, 0xd0, 0xa4, 0xd0, 0xf3,
+	0x44, 0xcf, 0xe3, 0xbc, 0x90, 0x5a, 0xa2, 0xdd, 0x1a, 0xf1, 0xbb, 0x54, 0x52, 0x69, 0xb1, 0x64,
+	0x55, 0x55, 0xb4, 0xbf, 0x4f, 0xa5, 0xa4, 0xe7, 0x24, 0xb1, 0xdd, 0xd4, 0x9c, 0x26, 0xa9, 0x28,
+	0x6b, 0x2a, 0xb8, 0x4d, 0xcd, 0x4c, 0x91, 0x6a, 0x26, 0x45, 0xc5, 0x47, 0x0a, 0xa2, 0x89, 0xa2,
+	0x98, 0x50, 0xa6, 0x34, 0x29, 0x0e, 0xb3, 0x4c, 0x1a, 0xa1, 0x51, 0x17, 0xee, 0xc8, 0x0f, 0x82,
+	0x14, 0x1e, 0xe8, 0x83, 0xc1, 0x03, 0x5c, 0x35, 0xe8, 0x35, 0x7c, 0x94, 0x49, 0x21, 0x48, 0xb6,
+	0xda, 0x3f, 0x61, 0x33, 0xef, 0xde, 0x8a, 0x1d, 0x79, 0xd7, 0x57, 0x61, 0xb7, 0x4c, 0xf9, 0xf9,
+	0x41, 0xf4, 0x17, 0x1d, 0xe1, 0x87, 0x37, 0xfd, 0x78, 0x76, 0xd0, 0xfe, 0x74, 0x11, 0x3a, 0xbf,
+	0x2f, 0x42, 0x27, 0xea, 0x41, 0x7f, 0xfb, 0xa3, 0x98, 0xa8, 0x5c, 0x0a, 0x45, 0xa2, 0x5f, 0x00,
+	0x76, 0x26, 0x8a, 0x1e, 0x99, 0x29, 0x67, 0xfa, 0x78, 0x7e, 0x27, 0x87, 0x41, 0x03, 0xe8, 0x72,
+	0x45, 0x95, 0xd7, 0xea, 0xb7, 0x06, 0x9d, 0x61, 0x37, 0xae, 0x6c, 0x8a, 0x1b, 0x9b, 0xe2, 0x43,
+	0x51, 0x62, 0x3b, 0x81, 0x5e, 0xc1, 0x5d, 0xcd, 0x38, 0x91, 0x46, 0x7b, 0x6e, 0x1f, 0x0c, 0x3a,
+	0xc3, 0xfd, 0xad, 0xe1, 0xb7, 0xb5, 0xa7, 0x23, 0xf7, 0xcb, 0x8f, 0x10, 0xe0, 0x66, 0x1e, 0x21,
+	0xe8, 0x72, 0xc2, 0xa5, 0xb7, 0x63, 0x0f, 0x6e, 0xeb, 0x0d, 0x17, 0x3e, 0xc2, 0xa7, 0x1b, 0x32,
+	0x1b, 0xf9, 0xc8, 0x87, 0x6d, 0x45, 0xde, 0x1b, 0x22, 0x32, 0x62, 0x15, 0xbb, 0x78, 0xdd, 0xa3,
+	0x31, 0x7c, 0x52, 0xbf, 0xfb, 0x64, 0xf5, 0x54, 0x3a, 0xe5, 0xb9, 0x15, 0xee, 0x8e, 0x7a, 0xd7,
+	0x57, 0xa1, 0x57, 0x09, 0xdf, 0x1a, 0x89, 0xf0, 0x5e, 0x8d, 0x1d, 0x37, 0xd0, 0xf0, 0x2b, 0x80,
+	0xad, 0x89, 0xa2, 0xe8, 0x08, 0x3e, 0xbe, 0x9d, 0xfe, 0xb3, 0xb8, 0xfe, 0xdd, 0xe2, 0xed, 0x94,
+	0xfc, 0xe7, 0xff, 0x21, 0xd7, 0x1a, 0xde, 0xc0, 0xf6, 0x4d, 0x7c, 0x9b, 0x0b, 0x0d, 0xea, 0xf7,
+	0xfe, 0x85, 0x36, 0xfb, 0xa3, 0xf1, 0xb7, 0x45, 0x00, 0x2e, 0x17, 0x01, 0xf8, 0xb9, 0x08, 0xc0,
+	0xe7, 0x65, 0xe0, 0x5c, 0x2e, 0x03, 0xe7, 0xfb, 0x32, 0x70, 0xde, 0x25, 0x94, 0xe9, 0x33, 0x33,
+	0x8d, 0x33, 0xc9, 0x93, 0x53, 0x53, 0x94, 0x2a, 0x97, 0x85, 0xb6, 0xd5, 0x8b, 0xec, 0x2c, 0x65,
+	0x22, 0x99, 0x27, 0xeb, 0xdb, 0x53, 0xe6, 0x44, 0x4d, 0xef, 0xdb, 0x94, 0x5e, 0xfe, 0x19, 0x00,
+	0x84, 0xf3, 0xa4, 0x5d, 0x55, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,17 +451,36 @@ func (m *MsgSubmitTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timeout != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout):])
+		if err1 != nil {
+			return 0, err1
 		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
@@ -467,6 +519,16 @@ func (m *MsgSubmitTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -521,8 +583,18 @@ func (m *MsgSubmitTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Msg != nil {
-		l = m.Msg.Size()
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Timeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Timeout)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
@@ -534,6 +606,12 @@ func (m *MsgSubmitTxResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
 	return n
 }
 
@@ -802,7 +880,41 @@ func (m *MsgSubmitTx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -829,13 +941,45 @@ func (m *MsgSubmitTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &types.Any{}
+			if m.Timeout == nil {
+				m.Timeout = new(time.Duration)
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -886,6 +1030,44 @@ func (m *MsgSubmitTxResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgSubmitTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])